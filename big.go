@@ -0,0 +1,85 @@
+package ro
+
+import (
+	"iter"
+	"math/big"
+)
+
+// bigNumber is satisfied by *big.Int and *big.Rat
+type bigNumber[T any] interface {
+	*T
+	Add(x, y *T) *T
+	Cmp(y *T) int
+	Set(x *T) *T
+	Sign() int
+}
+
+// bigRange yields fresh copies of the values from start by step while cond holds.
+// start and step are copied so the caller may reuse them.
+func bigRange[T any, P bigNumber[T]](start, step P, cond func(P) bool) iter.Seq[P] {
+	cur := P(new(T)).Set(start)
+	inc := P(new(T)).Set(step)
+	return func(yield func(P) bool) {
+		for i := cur; cond(i); i = P(new(T)).Add(i, inc) {
+			if !yield(P(new(T)).Set(i)) {
+				return
+			}
+		}
+	}
+}
+
+func bigBounded[T any, P bigNumber[T]](start, end, step P) iter.Seq[P] {
+	if start == nil || end == nil || step == nil || step.Sign() == 0 {
+		return empty[P]()
+	}
+	stop := P(new(T)).Set(end)
+	ascending := step.Sign() > 0
+	return bigRange(start, step, func(i P) bool {
+		if ascending {
+			return i.Cmp(stop) < 0
+		}
+		return i.Cmp(stop) > 0
+	})
+}
+
+func bigUnbounded[T any, P bigNumber[T]](start, step P) iter.Seq[P] {
+	if start == nil || step == nil {
+		return empty[P]()
+	}
+	return bigRange(start, step, func(P) bool { return true })
+}
+
+// BigRange returns an iterator that yields integers from start to end (exclusive) by step, like Range.
+// A negative step counts down from start to end.
+//
+// The arguments are copied, and every yielded value is freshly allocated, so neither the caller
+// nor the consumer can alias the iterator's state.
+// If step is 0 or any argument is nil, the iterator is empty.
+func BigRange(start, end, step *big.Int) iter.Seq[*big.Int] {
+	return bigBounded(start, end, step)
+}
+
+// BigCount returns an infinite iterator starting from start and incrementing by step, like Count.
+//
+// The arguments are copied, and every yielded value is freshly allocated.
+// If any argument is nil, the iterator is empty.
+func BigCount(start, step *big.Int) iter.Seq[*big.Int] {
+	return bigUnbounded(start, step)
+}
+
+// RatRange returns an iterator that yields exact rationals from start to end (exclusive) by step.
+// A negative step counts down from start to end.
+//
+// The arguments are copied, and every yielded value is freshly allocated.
+// If step is 0 or any argument is nil, the iterator is empty.
+func RatRange(start, end, step *big.Rat) iter.Seq[*big.Rat] {
+	return bigBounded(start, end, step)
+}
+
+// RatCount returns an infinite iterator of exact rationals starting from start and incrementing by step.
+//
+// The arguments are copied, and every yielded value is freshly allocated.
+// If any argument is nil, the iterator is empty.
+func RatCount(start, step *big.Rat) iter.Seq[*big.Rat] {
+	return bigUnbounded(start, step)
+}
@@ -0,0 +1,117 @@
+package ro_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func bigStrings(seq []*big.Int) []string {
+	return lo.Map(seq, func(v *big.Int, _ int) string { return v.String() })
+}
+
+func ratStrings(seq []*big.Rat) []string {
+	return lo.Map(seq, func(v *big.Rat, _ int) string { return v.RatString() })
+}
+
+func TestBigRange(t *testing.T) {
+	res := ro.ToSlice(ro.BigRange(big.NewInt(1), big.NewInt(10), big.NewInt(3)))
+	assert.Equal(t, []string{"1", "4", "7"}, bigStrings(res))
+
+	res2 := ro.ToSlice(ro.BigRange(big.NewInt(10), big.NewInt(1), big.NewInt(-3)))
+	assert.Equal(t, []string{"10", "7", "4"}, bigStrings(res2))
+
+	res3 := ro.ToSlice(ro.BigRange(big.NewInt(1), big.NewInt(10), big.NewInt(0)))
+	assert.Equal(t, []*big.Int{}, res3)
+
+	res4 := ro.ToSlice(ro.BigRange(big.NewInt(10), big.NewInt(1), big.NewInt(1)))
+	assert.Equal(t, []*big.Int{}, res4)
+
+	res5 := ro.ToSlice(ro.BigRange(nil, big.NewInt(1), big.NewInt(1)))
+	assert.Equal(t, []*big.Int{}, res5)
+
+	// ranges beyond uint64
+	start := new(big.Int).SetUint64(math.MaxUint64)
+	end := new(big.Int).Add(start, big.NewInt(3))
+	res6 := ro.ToSlice(ro.BigRange(start, end, big.NewInt(1)))
+	assert.Equal(t, []string{"18446744073709551615", "18446744073709551616", "18446744073709551617"}, bigStrings(res6))
+
+	// the caller's values are neither aliased nor mutated
+	start2, end2, step2 := big.NewInt(0), big.NewInt(3), big.NewInt(1)
+	seq := ro.BigRange(start2, end2, step2)
+	start2.SetInt64(100)
+	end2.SetInt64(100)
+	step2.SetInt64(100)
+	res7 := ro.ToSlice(seq)
+	assert.Equal(t, []string{"0", "1", "2"}, bigStrings(res7))
+	assert.Equal(t, []string{"100", "100", "100"}, bigStrings([]*big.Int{start2, end2, step2}))
+
+	// yielded values are fresh, so mutating one does not affect the iteration
+	res8 := []string{}
+	for v := range ro.BigRange(big.NewInt(0), big.NewInt(3), big.NewInt(1)) {
+		res8 = append(res8, v.String())
+		v.SetInt64(100)
+	}
+	assert.Equal(t, []string{"0", "1", "2"}, res8)
+
+	// the iterator can be ranged multiple times
+	assert.Equal(t, res7, ro.ToSlice(seq))
+
+	res9 := []*big.Int{}
+	for v := range ro.BigRange(big.NewInt(0), big.NewInt(3), big.NewInt(1)) {
+		res9 = append(res9, v)
+		break
+	}
+	assert.Equal(t, []string{"0"}, bigStrings(res9))
+}
+
+func TestBigCount(t *testing.T) {
+	start := new(big.Int).SetUint64(math.MaxUint64)
+	step := new(big.Int).SetUint64(math.MaxUint64)
+	res := ro.ToSlice(ro.Limit(ro.BigCount(start, step), 3))
+	assert.Equal(t, []string{"18446744073709551615", "36893488147419103230", "55340232221128654845"}, bigStrings(res))
+	assert.Equal(t, "18446744073709551615", start.String())
+
+	res2 := ro.ToSlice(ro.Limit(ro.Zip(ro.BigCount(big.NewInt(0), big.NewInt(-2)), ro.FromSlice([]string{"a", "b", "c"})), 3))
+	assert.Equal(t, []string{"0", "-2", "-4"}, bigStrings(lo.Map(res2, func(v lo.Tuple2[*big.Int, string], _ int) *big.Int { return v.A })))
+	assert.Equal(t, []string{"a", "b", "c"}, lo.Map(res2, func(v lo.Tuple2[*big.Int, string], _ int) string { return v.B }))
+
+	res3 := ro.ToSlice(ro.BigCount(big.NewInt(0), nil))
+	assert.Equal(t, []*big.Int{}, res3)
+}
+
+func TestRatRange(t *testing.T) {
+	res := ro.ToSlice(ro.RatRange(big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(1, 3)))
+	assert.Equal(t, []string{"0", "1/3", "2/3"}, ratStrings(res))
+
+	// exact steps : 10 steps of 1/10 reach 1 exactly
+	res2 := ro.ToSlice(ro.RatRange(big.NewRat(0, 1), big.NewRat(1, 1), big.NewRat(1, 10)))
+	assert.Len(t, res2, 10)
+	assert.Equal(t, "9/10", res2[9].RatString())
+
+	res3 := ro.ToSlice(ro.RatRange(big.NewRat(1, 2), big.NewRat(-1, 2), big.NewRat(-1, 4)))
+	assert.Equal(t, []string{"1/2", "1/4", "0", "-1/4"}, ratStrings(res3))
+
+	res4 := ro.ToSlice(ro.RatRange(big.NewRat(0, 1), big.NewRat(1, 1), new(big.Rat)))
+	assert.Equal(t, []*big.Rat{}, res4)
+
+	step := big.NewRat(1, 2)
+	seq := ro.RatRange(big.NewRat(0, 1), big.NewRat(2, 1), step)
+	step.SetInt64(5)
+	assert.Equal(t, []string{"0", "1/2", "1", "3/2"}, ratStrings(ro.ToSlice(seq)))
+}
+
+func TestRatCount(t *testing.T) {
+	res := ro.ToSlice(ro.Limit(ro.RatCount(big.NewRat(1, 3), big.NewRat(1, 6)), 4))
+	assert.Equal(t, []string{"1/3", "1/2", "2/3", "5/6"}, ratStrings(res))
+
+	res2 := ro.ToSlice(ro.Limit(ro.Zip(ro.RatCount(big.NewRat(0, 1), big.NewRat(1, 2)), ro.Range(0, 3, 1)), 3))
+	assert.Equal(t, []string{"0", "1/2", "1"}, ratStrings(lo.Map(res2, func(v lo.Tuple2[*big.Rat, int], _ int) *big.Rat { return v.A })))
+
+	res3 := ro.ToSlice(ro.RatCount(nil, big.NewRat(1, 2)))
+	assert.Equal(t, []*big.Rat{}, res3)
+}
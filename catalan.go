@@ -0,0 +1,138 @@
+package ro
+
+import (
+	"iter"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// BinaryTree is the shape of a full binary tree, where every node has either no children or both children
+type BinaryTree struct {
+	Left  *BinaryTree
+	Right *BinaryTree
+}
+
+// Catalan returns the n-th Catalan number, the number of balanced parentheses strings with n pairs,
+// of Dyck paths of length 2n and of full binary trees with n internal nodes.
+// If n < 0, 0 is returned.
+func Catalan(n int) *big.Int {
+	if n < 0 {
+		return big.NewInt(0)
+	}
+	c := Binomial(2*n, n)
+	return c.Quo(c, big.NewInt(int64(n+1)))
+}
+
+// DyckPaths returns an iterator that yields all Dyck paths with n up steps, as sequences of 2n steps of +1 (up) or -1 (down)
+// whose partial sums are never negative and whose total is 0, in lexicographic order with up steps first,
+// e.g. 2 yields [1, 1, -1, -1], [1, -1, 1, -1].
+// If n == 0, the empty path is yielded. If n < 0, the empty iterator is returned.
+//
+// Each yielded path is a newly allocated slice.
+func DyckPaths(n int) iter.Seq[[]int] {
+	if n < 0 {
+		return empty[[]int]()
+	}
+	return func(yield func([]int) bool) {
+		steps := make([]int, 2*n)
+		for i := range steps {
+			if i < n {
+				steps[i] = 1
+			} else {
+				steps[i] = -1
+			}
+		}
+		for {
+			if !yield(slices.Clone(steps)) {
+				return
+			}
+			if !nextDyckPath(steps) {
+				return
+			}
+		}
+	}
+}
+
+// nextDyckPath advances steps to the next Dyck path in lexicographic order, returning false if there is none
+func nextDyckPath(steps []int) bool {
+	n := len(steps) / 2
+	// find the last up step that can be turned into a down step without going below zero
+	pivot, upsBefore := -1, 0
+	depth, ups := 0, 0
+	for i, s := range steps {
+		if s == 1 && depth >= 1 {
+			pivot, upsBefore = i, ups
+		}
+		depth += s
+		if s == 1 {
+			ups++
+		}
+	}
+	if pivot < 0 {
+		return false
+	}
+	// the rest of the path is the smallest completion : every remaining up step, then every down step
+	steps[pivot] = -1
+	remaining := n - upsBefore
+	for i := pivot + 1; i < len(steps); i++ {
+		if remaining > 0 {
+			steps[i] = 1
+			remaining--
+		} else {
+			steps[i] = -1
+		}
+	}
+	return true
+}
+
+// BalancedParentheses returns an iterator that yields all balanced strings of n pairs of parentheses,
+// in lexicographic order with '(' before ')', e.g. 2 yields "(())", "()()".
+// If n == 0, the empty string is yielded. If n < 0, the empty iterator is returned.
+func BalancedParentheses(n int) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		var sb strings.Builder
+		for path := range DyckPaths(n) {
+			sb.Reset()
+			for _, s := range path {
+				if s == 1 {
+					sb.WriteByte('(')
+				} else {
+					sb.WriteByte(')')
+				}
+			}
+			if !yield(sb.String()) {
+				return
+			}
+		}
+	}
+}
+
+// BinaryTrees returns an iterator that yields all shapes of full binary trees with n internal nodes, and n+1 leaves.
+// Trees are yielded in the order of their encoding as Dyck paths, where a tree with subtrees L and R is encoded as
+// an up step, the encoding of L, a down step and the encoding of R, and a leaf is encoded as the empty path.
+// If n == 0, a single leaf is yielded. If n < 0, the empty iterator is returned.
+//
+// Each yielded tree is newly allocated.
+func BinaryTrees(n int) iter.Seq[*BinaryTree] {
+	return func(yield func(*BinaryTree) bool) {
+		for path := range DyckPaths(n) {
+			tree, _ := dyckToTree(path, 0)
+			if !yield(tree) {
+				return
+			}
+		}
+	}
+}
+
+// dyckToTree decodes the tree encoded by path starting at position i, up to the end of the path
+// or the unmatched down step closing its parent. It returns the position following the decoded tree.
+func dyckToTree(path []int, i int) (*BinaryTree, int) {
+	if i >= len(path) || path[i] == -1 {
+		return &BinaryTree{}, i
+	}
+	left, i := dyckToTree(path, i+1)
+	// skip the down step matching the up step at the root
+	right, i := dyckToTree(path, i+1)
+	return &BinaryTree{Left: left, Right: right}, i
+}
@@ -0,0 +1,107 @@
+package ro_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCatalan(t *testing.T) {
+	catalan := []int64{1, 1, 2, 5, 14, 42, 132, 429}
+	for n, c := range catalan {
+		assert.Equal(t, big.NewInt(c), ro.Catalan(n))
+	}
+	assert.Equal(t, big.NewInt(0), ro.Catalan(-1))
+
+	expected, _ := new(big.Int).SetString("896519947090131496687170070074100632420837521538745909320", 10)
+	assert.Equal(t, expected, ro.Catalan(100))
+}
+
+func TestDyckPaths(t *testing.T) {
+	res := ro.ToSlice(ro.DyckPaths(2))
+	assert.Equal(t, [][]int{{1, 1, -1, -1}, {1, -1, 1, -1}}, res)
+
+	res2 := ro.ToSlice(ro.DyckPaths(0))
+	assert.Equal(t, [][]int{{}}, res2)
+
+	res3 := ro.ToSlice(ro.DyckPaths(-1))
+	assert.Equal(t, [][]int{}, res3)
+
+	for n := 1; n <= 8; n++ {
+		paths := ro.ToSlice(ro.DyckPaths(n))
+		assert.Equal(t, ro.Catalan(n), big.NewInt(int64(len(paths))))
+		for _, p := range paths {
+			assert.Len(t, p, 2*n)
+			depth := 0
+			for _, s := range p {
+				depth += s
+				assert.GreaterOrEqual(t, depth, 0)
+			}
+			assert.Equal(t, 0, depth)
+		}
+	}
+}
+
+func TestBalancedParentheses(t *testing.T) {
+	res := ro.ToSlice(ro.BalancedParentheses(3))
+	assert.Equal(t, []string{"((()))", "(()())", "(())()", "()(())", "()()()"}, res)
+
+	res2 := ro.ToSlice(ro.BalancedParentheses(0))
+	assert.Equal(t, []string{""}, res2)
+
+	res3 := ro.ToSlice(ro.BalancedParentheses(-2))
+	assert.Equal(t, []string{}, res3)
+
+	res4 := []string{}
+	for v := range ro.BalancedParentheses(3) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, []string{"((()))"}, res4)
+
+	res5 := ro.ToSlice(ro.BalancedParentheses(6))
+	assert.Len(t, res5, 132)
+	assert.Len(t, lo.Uniq(res5), 132)
+}
+
+// countNodes returns the number of internal nodes and leaves of the tree
+func countNodes(tree *ro.BinaryTree) (int, int) {
+	if tree.Left == nil && tree.Right == nil {
+		return 0, 1
+	}
+	li, ll := countNodes(tree.Left)
+	ri, rl := countNodes(tree.Right)
+	return li + ri + 1, ll + rl
+}
+
+func TestBinaryTrees(t *testing.T) {
+	leaf := func() *ro.BinaryTree { return &ro.BinaryTree{} }
+	node := func(l, r *ro.BinaryTree) *ro.BinaryTree { return &ro.BinaryTree{Left: l, Right: r} }
+
+	res := ro.ToSlice(ro.BinaryTrees(2))
+	assert.Equal(t, []*ro.BinaryTree{
+		// (()) : the root's left subtree has one internal node
+		node(node(leaf(), leaf()), leaf()),
+		// ()() : the root's right subtree has one internal node
+		node(leaf(), node(leaf(), leaf())),
+	}, res)
+
+	res2 := ro.ToSlice(ro.BinaryTrees(0))
+	assert.Equal(t, []*ro.BinaryTree{leaf()}, res2)
+
+	res3 := ro.ToSlice(ro.BinaryTrees(-1))
+	assert.Equal(t, []*ro.BinaryTree{}, res3)
+
+	for n := 1; n <= 7; n++ {
+		trees := ro.ToSlice(ro.BinaryTrees(n))
+		assert.Equal(t, ro.Catalan(n), big.NewInt(int64(len(trees))))
+		for _, tree := range trees {
+			internal, leaves := countNodes(tree)
+			assert.Equal(t, n, internal)
+			assert.Equal(t, n+1, leaves)
+		}
+	}
+}
@@ -1,8 +1,9 @@
 package ro
 
 import (
+	"cmp"
 	"iter"
-	"math/bits"
+	"slices"
 
 	"github.com/samber/lo"
 )
@@ -106,11 +107,13 @@ func ProductSlice[U, V any](arr1 []U, arr2 []V) iter.Seq[lo.Tuple2[U, V]] {
 // Product returns an iterator that yields the cartesian product of seq1 and seq2 as a tuple.
 // The returned iterator of tuples is ordered [A1, B1], [A1, B2], [A2, B1], [A2, B2], ...
 //
-// Expects to fully consume seq1 before seq2
+// seq2 is only consumed once, its elements are cached (see Memoize) and replayed for each element of seq1
 func Product[U, V any](seq1 iter.Seq[U], seq2 iter.Seq[V]) iter.Seq[lo.Tuple2[U, V]] {
 	return func(yield func(lo.Tuple2[U, V]) bool) {
+		cached, stop := Memoize(seq2)
+		defer stop()
 		for u := range seq1 {
-			for v := range seq2 {
+			for v := range cached {
 				if !yield(lo.Tuple2[U, V]{A: u, B: v}) {
 					return
 				}
@@ -119,12 +122,141 @@ func Product[U, V any](seq1 iter.Seq[U], seq2 iter.Seq[V]) iter.Seq[lo.Tuple2[U,
 	}
 }
 
-// Permutations returns an iterator that yields all possible n-length permutations of the slice
+// DiagonalProduct returns an iterator that yields the cartesian product of seq1 and seq2 as a tuple,
+// enumerating pairs along the Cantor diagonals :
+// [A1, B1], [A1, B2], [A2, B1], [A1, B3], [A2, B2], [A3, B1], ...
+// Unlike Product, every pair is eventually reached even if both sequences are infinite.
+//
+// Each sequence is only consumed once, and the elements yielded so far are buffered.
+func DiagonalProduct[U, V any](seq1 iter.Seq[U], seq2 iter.Seq[V]) iter.Seq[lo.Tuple2[U, V]] {
+	return func(yield func(lo.Tuple2[U, V]) bool) {
+		p1, stop1 := iter.Pull(seq1)
+		defer stop1()
+		p2, stop2 := iter.Pull(seq2)
+		defer stop2()
+		var as []U
+		var bs []V
+		done1, done2 := false, false
+		for d := 0; ; d++ {
+			// diagonal d needs up to d+1 elements of each sequence
+			if !done1 {
+				if a, ok := p1(); ok {
+					as = append(as, a)
+				} else {
+					done1 = true
+				}
+			}
+			if !done2 {
+				if b, ok := p2(); ok {
+					bs = append(bs, b)
+				} else {
+					done2 = true
+				}
+			}
+			if len(as) == 0 || len(bs) == 0 {
+				return
+			}
+			if done1 && done2 && d > len(as)+len(bs)-2 {
+				return
+			}
+			for i := max(0, d-len(bs)+1); i <= min(d, len(as)-1); i++ {
+				if !yield(lo.Tuple2[U, V]{A: as[i], B: bs[d-i]}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// ProductN returns an iterator that yields the cartesian product of the slices.
+// The returned iterator is ordered like an odometer, where the last slice advances the fastest :
+// [A1, B1], [A1, B2], [A2, B1], [A2, B2], ...
+// If no slices are given or any slice is empty, the empty iterator is returned.
+//
+// Each yielded product is a newly allocated slice, see ProductNInPlace to avoid the allocation.
+func ProductN[T any](arrs ...[]T) iter.Seq[[]T] {
+	return productN(arrs, false)
+}
+
+// ProductNInPlace returns an iterator that yields the cartesian product of the slices like ProductN.
+//
+// The same slice is reused for each yielded product, so callers must copy it to retain it past the current iteration.
+func ProductNInPlace[T any](arrs ...[]T) iter.Seq[[]T] {
+	return productN(arrs, true)
+}
+
+// ProductRepeat returns an iterator that yields the cartesian product of the slice with itself r times,
+// equivalent to ProductN with r copies of arr.
+// If r <= 0, the empty iterator is returned.
+//
+// Each yielded product is a newly allocated slice, see ProductRepeatInPlace to avoid the allocation.
+func ProductRepeat[T any](arr []T, r int) iter.Seq[[]T] {
+	return productN(repeatSlice(arr, r), false)
+}
+
+// ProductRepeatInPlace returns an iterator that yields the cartesian product of the slice with itself r times
+// like ProductRepeat.
+//
+// The same slice is reused for each yielded product, so callers must copy it to retain it past the current iteration.
+func ProductRepeatInPlace[T any](arr []T, r int) iter.Seq[[]T] {
+	return productN(repeatSlice(arr, r), true)
+}
+
+func repeatSlice[T any](arr []T, r int) [][]T {
+	arrs := make([][]T, max(r, 0))
+	for i := range arrs {
+		arrs[i] = arr
+	}
+	return arrs
+}
+
+func productN[T any](arrs [][]T, inPlace bool) iter.Seq[[]T] {
+	if len(arrs) == 0 {
+		return empty[[]T]()
+	}
+	for _, arr := range arrs {
+		if len(arr) == 0 {
+			return empty[[]T]()
+		}
+	}
+	return func(yield func([]T) bool) {
+		n := len(arrs)
+		indices := make([]int, n)
+		output := make([]T, n)
+		for i, arr := range arrs {
+			output[i] = arr[0]
+		}
+		for {
+			if inPlace {
+				if !yield(output) {
+					return
+				}
+			} else if !yield(slices.Clone(output)) {
+				return
+			}
+			i := n - 1
+			for ; i >= 0; i-- {
+				indices[i]++
+				if indices[i] < len(arrs[i]) {
+					output[i] = arrs[i][indices[i]]
+					break
+				}
+				indices[i] = 0
+				output[i] = arrs[i][0]
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Permutations returns an iterator that yields all possible k-length permutations of the slice in lexicographic order
+// of the element positions, e.g. [A, B, C] with k = 2 yields [A, B], [A, C], [B, A], [B, C], [C, A], [C, B].
 // If k <= 0, the empty iterator is returned.
 // If k > len(seq), the iterator yields all permutations of seq
 //
-// Note : allocates a state buffer of the same size as the input to keep track of visited permutations
-// as we iterate through them.
+// The input slice is not modified, and each yielded permutation is a newly allocated slice.
 func Permutations[T any](arr []T, k int) iter.Seq[[]T] {
 	if k <= 0 {
 		return empty[[]T]()
@@ -136,61 +268,410 @@ func Permutations[T any](arr []T, k int) iter.Seq[[]T] {
 
 	return func(yield func([]T) bool) {
 		n := len(arr)
-		output := make([]T, k)
-		copy(output, arr)
-		if !yield(output) {
-			return
+		indices := make([]int, n)
+		for i := range indices {
+			indices[i] = i
 		}
-		state := make([]int, n)
-		i := 0
-		for i < n {
-			if state[i] < i {
-				if i%2 == 0 {
-					arr[0], arr[i] = arr[i], arr[0]
-				} else {
-					arr[state[i]], arr[i] = arr[i], arr[state[i]]
-				}
-				output := make([]T, k)
-				copy(output, arr)
-				if !yield(output) {
-					return
-				}
-				state[i]++
-				i = 0
+		for {
+			output := make([]T, k)
+			for i, idx := range indices[:k] {
+				output[i] = arr[idx]
+			}
+			if !yield(output) {
+				return
+			}
+			if !nextKPermutation(indices, k) {
+				return
+			}
+		}
+	}
+}
+
+// nextKPermutation advances indices to the next k-permutation in lexicographic order, returning false if there is none.
+// indices is a permutation of 0..n-1, whose first k entries hold the current k-permutation
+// and whose remaining entries are sorted in increasing order.
+func nextKPermutation(indices []int, k int) bool {
+	// with the tail in decreasing order, the next permutation of the whole slice
+	// is the next k-permutation with its tail back in increasing order
+	slices.Reverse(indices[k:])
+	i := len(indices) - 2
+	for i >= 0 && indices[i] >= indices[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(indices) - 1
+	for indices[j] <= indices[i] {
+		j--
+	}
+	indices[i], indices[j] = indices[j], indices[i]
+	slices.Reverse(indices[i+1:])
+	return true
+}
+
+// PermutationsMinimalChange returns an iterator that yields all possible k-length permutations of the slice,
+// where each permutation differs from the previous one either by swapping two elements
+// or by replacing a single element with one that is not in the previous permutation.
+// If k <= 0, the empty iterator is returned.
+// If k > len(seq), the iterator yields all permutations of seq
+//
+// The input slice is not modified, and each yielded permutation is a newly allocated slice.
+//
+// Note : permutations of each k-length combination are generated using Heap's algorithm,
+// and combinations are visited in revolving door order.
+func PermutationsMinimalChange[T any](arr []T, k int) iter.Seq[[]T] {
+	if k <= 0 {
+		return empty[[]T]()
+	}
+
+	if k > len(arr) {
+		k = len(arr)
+	}
+
+	return func(yield func([]T) bool) {
+		n := len(arr)
+		// current holds the indices of the elements of the current permutation
+		current := make([]int, k)
+		emit := func() bool {
+			output := make([]T, k)
+			for i, idx := range current {
+				output[i] = arr[idx]
+			}
+			return yield(output)
+		}
+		var prev []int
+		revolvingDoor(n, k, func(comb []int) bool {
+			if prev == nil {
+				copy(current, comb)
+				prev = make([]int, k)
+			} else {
+				// consecutive combinations differ by exactly one element
+				out, in := symmetricDifference(prev, comb)
+				current[slices.Index(current, out)] = in
+			}
+			copy(prev, comb)
+			return heapPermutations(current, emit)
+		})
+	}
+}
+
+// symmetricDifference returns the element only in a and the element only in b, for sorted slices
+// of the same length differing by exactly one element
+func symmetricDifference(a, b []int) (int, int) {
+	i, j := 0, 0
+	out, in := -1, -1
+	for i < len(a) || j < len(b) {
+		switch {
+		case j >= len(b) || (i < len(a) && a[i] < b[j]):
+			out = a[i]
+			i++
+		case i >= len(a) || b[j] < a[i]:
+			in = b[j]
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return out, in
+}
+
+// heapPermutations permutes arr in place using Heap's algorithm, calling emit after each swap.
+// It returns false if emit returns false.
+//
+// Note : allocates a state buffer of the same size as the input to keep track of visited permutations
+// as we iterate through them.
+func heapPermutations(arr []int, emit func() bool) bool {
+	if !emit() {
+		return false
+	}
+	n := len(arr)
+	state := make([]int, n)
+	i := 0
+	for i < n {
+		if state[i] < i {
+			if i%2 == 0 {
+				arr[0], arr[i] = arr[i], arr[0]
 			} else {
-				state[i] = 0
-				i++
+				arr[state[i]], arr[i] = arr[i], arr[state[i]]
+			}
+			if !emit() {
+				return false
+			}
+			state[i]++
+			i = 0
+		} else {
+			state[i] = 0
+			i++
+		}
+	}
+	return true
+}
+
+// revolvingDoor calls emit with each k-combination of 0..n-1 in revolving door order,
+// where consecutive combinations differ by exchanging a single element.
+// The combination passed to emit is sorted in increasing order and reused between calls.
+// It returns false if emit returns false.
+func revolvingDoor(n, k int, emit func([]int) bool) bool {
+	comb := make([]int, k)
+	var gen func(n, k int, reverse bool) bool
+	gen = func(n, k int, reverse bool) bool {
+		if k == 0 || k == n {
+			for i := 0; i < k; i++ {
+				comb[i] = i
 			}
+			return emit(comb)
+		}
+		// R(n, k) = R(n-1, k) followed by reversed R(n-1, k-1) with n-1 appended
+		withLast := func(reverse bool) bool {
+			comb[k-1] = n - 1
+			return gen(n-1, k-1, reverse)
 		}
+		if !reverse {
+			return gen(n-1, k, false) && withLast(true)
+		}
+		return withLast(false) && gen(n-1, k, true)
 	}
+	return gen(n, k, false)
 }
 
-// Combinations returns an iterator that yields all possible k-length combinations of the slice
+// Combinations returns an iterator that yields all possible k-length combinations of the slice in lexicographic order
+// of the element positions, e.g. [A, B, C, D] with k = 2 yields [A, B], [A, C], [A, D], [B, C], [B, D], [C, D].
 // If k <= 0, the empty iterator is returned.
 // If k > len(seq), the iterator yields all combinations of seq
+//
+// Each yielded combination is a newly allocated slice.
 func Combinations[T any](arr []T, k int) iter.Seq[[]T] {
-	length := uint(len(arr))
+	if k > len(arr) {
+		k = len(arr)
+	}
 	if k <= 0 {
 		return empty[[]T]()
 	}
-	if k > len(arr) {
-		k = len(arr)
+	return func(yield func([]T) bool) {
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+		for {
+			subset := make([]T, k)
+			for i, idx := range indices {
+				subset[i] = arr[idx]
+			}
+			if !yield(subset) {
+				return
+			}
+			if !nextCombination(indices, len(arr)) {
+				return
+			}
+		}
+	}
+}
+
+// nextCombination advances indices, an increasing sequence of k positions in 0..n-1,
+// to the next combination in lexicographic order, returning false if there is none.
+func nextCombination(indices []int, n int) bool {
+	k := len(indices)
+	i := k - 1
+	for i >= 0 && indices[i] == n-k+i {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	indices[i]++
+	for j := i + 1; j < k; j++ {
+		indices[j] = indices[j-1] + 1
+	}
+	return true
+}
+
+// CombinationsWithReplacement returns an iterator that yields all possible k-length combinations of the slice,
+// allowing individual elements to be repeated, in lexicographic order of the element positions,
+// e.g. [A, B, C] with k = 2 yields [A, A], [A, B], [A, C], [B, B], [B, C], [C, C].
+// If k <= 0 or the slice is empty, the empty iterator is returned.
+// Since elements can be repeated, k may exceed len(arr), e.g. [A, B] with k = 3 yields [A, A, A], [A, A, B], [A, B, B], [B, B, B].
+//
+// Each yielded combination is a newly allocated slice.
+func CombinationsWithReplacement[T any](arr []T, k int) iter.Seq[[]T] {
+	if k <= 0 || len(arr) == 0 {
+		return empty[[]T]()
+	}
+	return func(yield func([]T) bool) {
+		n := len(arr)
+		indices := make([]int, k)
+		for {
+			subset := make([]T, k)
+			for i, idx := range indices {
+				subset[i] = arr[idx]
+			}
+			if !yield(subset) {
+				return
+			}
+			i := k - 1
+			for i >= 0 && indices[i] == n-1 {
+				i--
+			}
+			if i < 0 {
+				return
+			}
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[i]
+			}
+		}
+	}
+}
+
+// CombinationsWithReplacementSeq returns an iterator that yields all possible k-length combinations of the elements
+// yielded by seq, allowing individual elements to be repeated. See CombinationsWithReplacement.
+//
+// The sequence is consumed entirely before the first combination is yielded.
+func CombinationsWithReplacementSeq[T any](seq iter.Seq[T], k int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for v := range CombinationsWithReplacement(ToSlice(seq), k) {
+			if !yield(v) {
+				return
+			}
+		}
 	}
+}
+
+// Powerset returns an iterator that yields all subsets of the slice ordered by size, starting with the empty subset.
+// Subsets of the same size are yielded in the same order as Combinations.
+//
+// Each yielded subset is a newly allocated slice.
+func Powerset[T any](arr []T) iter.Seq[[]T] {
 	return func(yield func([]T) bool) {
-		for subsetBits := 1; subsetBits < (1 << length); subsetBits++ {
-			if k > 0 && bits.OnesCount(uint(subsetBits)) != k {
-				continue
+		if !yield([]T{}) {
+			return
+		}
+		for k := 1; k <= len(arr); k++ {
+			for v := range Combinations(arr, k) {
+				if !yield(v) {
+					return
+				}
 			}
+		}
+	}
+}
 
-			var subset []T
+// PowersetSeq returns an iterator that yields all subsets of the elements yielded by seq ordered by size.
+// See Powerset.
+//
+// The sequence is consumed entirely before the first subset is yielded.
+func PowersetSeq[T any](seq iter.Seq[T]) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		for v := range Powerset(ToSlice(seq)) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MultisetPermutations returns an iterator that yields each distinct permutation of the slice exactly once,
+// in lexicographic order, even if the slice contains repeated elements,
+// e.g. [1, 1, 2] yields [1, 1, 2], [1, 2, 1], [2, 1, 1].
+//
+// The input slice is not modified, and each yielded permutation is a newly allocated slice.
+func MultisetPermutations[T cmp.Ordered](arr []T) iter.Seq[[]T] {
+	return MultisetPermutationsFunc(arr, cmp.Compare[T])
+}
 
-			for object := uint(0); object < length; object++ {
-				if (subsetBits>>object)&1 == 1 {
-					subset = append(subset, arr[object])
+// MultisetPermutationsFunc returns an iterator that yields each distinct permutation of the slice exactly once,
+// like MultisetPermutations, using the comparison function to order and identify elements.
+// compare(a, b) should return a negative number when a < b, a positive number when a > b and zero when a == b.
+//
+// The input slice is not modified, and each yielded permutation is a newly allocated slice.
+func MultisetPermutationsFunc[T any](arr []T, compare func(a, b T) int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		// Knuth's Algorithm L, visiting permutations of a sorted copy in lexicographic order
+		current := slices.Clone(arr)
+		slices.SortFunc(current, compare)
+		n := len(current)
+		for {
+			if !yield(slices.Clone(current)) {
+				return
+			}
+			j := n - 2
+			for j >= 0 && compare(current[j], current[j+1]) >= 0 {
+				j--
+			}
+			if j < 0 {
+				return
+			}
+			l := n - 1
+			for compare(current[j], current[l]) >= 0 {
+				l--
+			}
+			current[j], current[l] = current[l], current[j]
+			slices.Reverse(current[j+1:])
+		}
+	}
+}
+
+// PermutationsWhere returns an iterator that yields the permutations of the slice for which allowed(pos, v)
+// holds for every element v placed at position pos, in the same order as Permutations.
+//
+// Permutations are built one position at a time, and a partial permutation is abandoned as soon as
+// an element is not allowed at its position, so the permutations that are not yielded are never fully explored.
+// Each yielded permutation is a newly allocated slice.
+func PermutationsWhere[T any](arr []T, allowed func(pos int, v T) bool) iter.Seq[[]T] {
+	return permutationsWhere(arr, func(pos, idx int) bool {
+		return allowed(pos, arr[idx])
+	})
+}
+
+// Derangements returns an iterator that yields the permutations of the slice where no element remains at its
+// original position, in the same order as Permutations, e.g. [A, B, C] yields [B, C, A], [C, A, B].
+// Elements are identified by their position, so repeated elements in the slice are considered distinct.
+//
+// Each yielded derangement is a newly allocated slice.
+func Derangements[T any](arr []T) iter.Seq[[]T] {
+	return permutationsWhere(arr, func(pos, idx int) bool {
+		return pos != idx
+	})
+}
+
+// permutationsWhere yields the permutations of arr where allowed(pos, idx) holds for the element of arr
+// at index idx placed at position pos, using backtracking
+func permutationsWhere[T any](arr []T, allowed func(pos, idx int) bool) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		n := len(arr)
+		indices := make([]int, n)
+		used := make([]bool, n)
+		// next[pos] is the smallest index left to try at pos
+		next := make([]int, n+1)
+		pos := 0
+		for pos >= 0 {
+			if pos == n {
+				if !yield(pick(arr, indices)) {
+					return
+				}
+			} else {
+				placed := false
+				for idx := next[pos]; idx < n; idx++ {
+					if !used[idx] && allowed(pos, idx) {
+						indices[pos] = idx
+						used[idx] = true
+						next[pos] = idx + 1
+						placed = true
+						break
+					}
+				}
+				if placed {
+					pos++
+					next[pos] = 0
+					continue
 				}
 			}
-			if !yield(subset) {
-				break
+			// backtrack
+			next[pos] = 0
+			pos--
+			if pos >= 0 {
+				used[indices[pos]] = false
 			}
 		}
 	}
@@ -1,6 +1,10 @@
 package ro_test
 
 import (
+	"fmt"
+	"iter"
+	"math/bits"
+	"slices"
 	"testing"
 
 	"github.com/alexandreLamarre/ro"
@@ -275,13 +279,13 @@ func TestPermutations(t *testing.T) {
 	for v := range ro.Permutations([]int{1, 2, 3}, 2) {
 		res = append(res, v)
 	}
-	assert.Equal(t, [][]int{{1, 2}, {2, 1}, {3, 1}, {1, 3}, {2, 3}, {3, 2}}, res)
+	assert.Equal(t, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}}, res)
 
 	res2 := [][]int{}
 	for v := range ro.Permutations([]int{1, 2, 3}, 3) {
 		res2 = append(res2, v)
 	}
-	assert.Equal(t, [][]int{{1, 2, 3}, {2, 1, 3}, {3, 1, 2}, {1, 3, 2}, {2, 3, 1}, {3, 2, 1}}, res2)
+	assert.Equal(t, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}, res2)
 
 	res3 := [][]int{}
 	for v := range ro.Permutations([]int{1, 2, 3}, 0) {
@@ -290,10 +294,10 @@ func TestPermutations(t *testing.T) {
 	assert.Equal(t, [][]int{}, res3)
 
 	res4 := [][]int{}
-	for v := range ro.Permutations([]int{1, 2, 3}, 3) {
+	for v := range ro.Permutations([]int{1, 2, 3, 4}, 1) {
 		res4 = append(res4, v)
 	}
-	assert.Equal(t, [][]int{{1, 2, 3}, {2, 1, 3}, {3, 1, 2}, {1, 3, 2}, {2, 3, 1}, {3, 2, 1}}, res4)
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}}, res4)
 
 	res5 := [][]int{}
 	for v := range ro.Permutations([]int{1, 2, 3}, 3) {
@@ -306,7 +310,69 @@ func TestPermutations(t *testing.T) {
 	for v := range ro.Permutations([]int{1, 2, 3}, 5) {
 		res6 = append(res6, v)
 	}
-	assert.Equal(t, [][]int{{1, 2, 3}, {2, 1, 3}, {3, 1, 2}, {1, 3, 2}, {2, 3, 1}, {3, 2, 1}}, res6)
+	assert.Equal(t, [][]int{{1, 2, 3}, {1, 3, 2}, {2, 1, 3}, {2, 3, 1}, {3, 1, 2}, {3, 2, 1}}, res6)
+
+	// k < n yields each of the nPk distinct k-permutations exactly once, without modifying the input
+	arr := []int{0, 1, 2, 3, 4}
+	res7 := ro.ToSlice(ro.Permutations(arr, 3))
+	assert.Len(t, res7, 60)
+	assert.Len(t, lo.UniqBy(res7, func(p []int) [3]int { return [3]int(p) }), 60)
+	assert.True(t, slices.IsSortedFunc(res7, slices.Compare[[]int]))
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, arr)
+
+	res8 := [][]int{}
+	for v := range ro.Permutations([]int{}, 2) {
+		res8 = append(res8, v)
+	}
+	assert.Equal(t, [][]int{{}}, res8)
+}
+
+// isMinimalChange reports whether b differs from a either by swapping two positions or by replacing one element
+func isMinimalChange(a, b []int) bool {
+	diff := []int{}
+	for i := range a {
+		if a[i] != b[i] {
+			diff = append(diff, i)
+		}
+	}
+	switch len(diff) {
+	case 1:
+		return !slices.Contains(a, b[diff[0]])
+	case 2:
+		return a[diff[0]] == b[diff[1]] && a[diff[1]] == b[diff[0]]
+	default:
+		return false
+	}
+}
+
+func TestPermutationsMinimalChange(t *testing.T) {
+	res := ro.ToSlice(ro.PermutationsMinimalChange([]int{1, 2, 3}, 3))
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 1, 3}, {3, 1, 2}, {1, 3, 2}, {2, 3, 1}, {3, 2, 1}}, res)
+
+	res2 := ro.ToSlice(ro.PermutationsMinimalChange([]int{1, 2, 3}, 0))
+	assert.Equal(t, [][]int{}, res2)
+
+	res3 := ro.ToSlice(ro.PermutationsMinimalChange([]int{1, 2, 3}, 5))
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 1, 3}, {3, 1, 2}, {1, 3, 2}, {2, 3, 1}, {3, 2, 1}}, res3)
+
+	for n := 1; n <= 6; n++ {
+		for k := 1; k <= n; k++ {
+			arr := ro.ToSlice(ro.Range(0, n, 1))
+			perms := ro.ToSlice(ro.PermutationsMinimalChange(arr, k))
+			assert.ElementsMatch(t, ro.ToSlice(ro.Permutations(arr, k)), perms, "n=%d k=%d", n, k)
+			for p := range ro.PairWiseSlice(perms) {
+				assert.True(t, isMinimalChange(p[0], p[1]), "n=%d k=%d %v -> %v", n, k, p[0], p[1])
+			}
+			assert.Equal(t, ro.ToSlice(ro.Range(0, n, 1)), arr)
+		}
+	}
+
+	res4 := [][]int{}
+	for v := range ro.PermutationsMinimalChange([]int{1, 2, 3}, 2) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, [][]int{{1, 2}}, res4)
 }
 
 func TestCombinations(t *testing.T) {
@@ -314,7 +380,7 @@ func TestCombinations(t *testing.T) {
 	for v := range ro.Combinations([]string{"A", "B", "C", "D"}, 2) {
 		res = append(res, v)
 	}
-	assert.Equal(t, [][]string{{"A", "B"}, {"A", "C"}, {"B", "C"}, {"A", "D"}, {"B", "D"}, {"C", "D"}}, res)
+	assert.Equal(t, [][]string{{"A", "B"}, {"A", "C"}, {"A", "D"}, {"B", "C"}, {"B", "D"}, {"C", "D"}}, res)
 
 	res2 := [][]string{}
 	for v := range ro.Combinations([]string{"A", "B", "C", "D"}, 0) {
@@ -342,3 +408,406 @@ func TestCombinations(t *testing.T) {
 		},
 	}, res4)
 }
+
+func TestCombinationsLarge(t *testing.T) {
+	// more elements than fit in a 64-bit mask
+	arr := ro.ToSlice(ro.Range(0, 100, 1))
+	count := 0
+	var first, last []int
+	for v := range ro.Combinations(arr, 3) {
+		if first == nil {
+			first = v
+		}
+		last = v
+		count++
+	}
+	assert.Equal(t, 161700, count)
+	assert.Equal(t, []int{0, 1, 2}, first)
+	assert.Equal(t, []int{97, 98, 99}, last)
+
+	res := ro.ToSlice(ro.Combinations(ro.ToSlice(ro.Range(0, 6, 1)), 3))
+	assert.Len(t, res, 20)
+	assert.True(t, slices.IsSortedFunc(res, slices.Compare[[]int]))
+
+	res2 := ro.ToSlice(ro.Combinations([]int{}, 2))
+	assert.Equal(t, [][]int{}, res2)
+}
+
+// bitmaskCombinations is the previous implementation of Combinations, which scans every subset of arr
+func bitmaskCombinations[T any](arr []T, k int) iter.Seq[[]T] {
+	length := uint(len(arr))
+	return func(yield func([]T) bool) {
+		for subsetBits := 1; subsetBits < (1 << length); subsetBits++ {
+			if bits.OnesCount(uint(subsetBits)) != k {
+				continue
+			}
+			var subset []T
+			for object := uint(0); object < length; object++ {
+				if (subsetBits>>object)&1 == 1 {
+					subset = append(subset, arr[object])
+				}
+			}
+			if !yield(subset) {
+				break
+			}
+		}
+	}
+}
+
+func BenchmarkCombinations(b *testing.B) {
+	for _, n := range []int{10, 20} {
+		arr := ro.ToSlice(ro.Range(0, n, 1))
+		b.Run(fmt.Sprintf("n=%d/k=3/index", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for range ro.Combinations(arr, 3) {
+				}
+			}
+		})
+		b.Run(fmt.Sprintf("n=%d/k=3/bitmask", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				for range bitmaskCombinations(arr, 3) {
+				}
+			}
+		})
+	}
+	arr := ro.ToSlice(ro.Range(0, 40, 1))
+	b.Run("n=40/k=3/index", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for range ro.Combinations(arr, 3) {
+			}
+		}
+	})
+}
+
+func TestProductSinglePass(t *testing.T) {
+	src, produced := onceSeq([]int{4, 5})
+	res := ro.ToSlice(ro.Product(ro.FromSlice([]int{1, 2, 3}), src))
+	assert.Equal(t, []lo.Tuple2[int, int]{
+		{A: 1, B: 4}, {A: 1, B: 5},
+		{A: 2, B: 4}, {A: 2, B: 5},
+		{A: 3, B: 4}, {A: 3, B: 5},
+	}, res)
+	assert.Equal(t, 2, *produced)
+
+	res2 := ro.ToSlice(ro.Product(ro.FromSlice([]int{1, 2}), ro.FromSlice([]int{})))
+	assert.Equal(t, []lo.Tuple2[int, int]{}, res2)
+}
+
+func TestCombinationsWithReplacement(t *testing.T) {
+	res := ro.ToSlice(ro.CombinationsWithReplacement([]string{"A", "B", "C"}, 2))
+	assert.Equal(t, [][]string{{"A", "A"}, {"A", "B"}, {"A", "C"}, {"B", "B"}, {"B", "C"}, {"C", "C"}}, res)
+
+	res2 := ro.ToSlice(ro.CombinationsWithReplacement([]string{"A", "B", "C"}, 0))
+	assert.Equal(t, [][]string{}, res2)
+
+	res3 := [][]string{}
+	for v := range ro.CombinationsWithReplacement([]string{"A", "B", "C"}, 2) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, [][]string{{"A", "A"}}, res3)
+
+	// k > len
+	res4 := ro.ToSlice(ro.CombinationsWithReplacement([]string{"A", "B"}, 3))
+	assert.Equal(t, [][]string{{"A", "A", "A"}, {"A", "A", "B"}, {"A", "B", "B"}, {"B", "B", "B"}}, res4)
+	assert.Len(t, ro.ToSlice(ro.CombinationsWithReplacement([]string{"A", "B"}, 5)), 6)
+
+	res6 := ro.ToSlice(ro.CombinationsWithReplacement([]string{}, 2))
+	assert.Equal(t, [][]string{}, res6)
+
+	// C(n+k-1, k) combinations
+	res5 := ro.ToSlice(ro.CombinationsWithReplacement(ro.ToSlice(ro.Range(0, 6, 1)), 3))
+	assert.Len(t, res5, 56)
+	assert.True(t, slices.IsSortedFunc(res5, slices.Compare[[]int]))
+}
+
+func TestCombinationsWithReplacementSeq(t *testing.T) {
+	res := ro.ToSlice(ro.CombinationsWithReplacementSeq(ro.FromSlice([]int{1, 2}), 2))
+	assert.Equal(t, [][]int{{1, 1}, {1, 2}, {2, 2}}, res)
+
+	res2 := ro.ToSlice(ro.CombinationsWithReplacementSeq(ro.FromSlice([]int{1, 2}), -1))
+	assert.Equal(t, [][]int{}, res2)
+
+	res3 := [][]int{}
+	for v := range ro.CombinationsWithReplacementSeq(ro.FromSlice([]int{1, 2}), 2) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, [][]int{{1, 1}}, res3)
+}
+
+func TestPowerset(t *testing.T) {
+	res := ro.ToSlice(ro.Powerset([]string{"A", "B", "C"}))
+	assert.Equal(t, [][]string{
+		{},
+		{"A"}, {"B"}, {"C"},
+		{"A", "B"}, {"A", "C"}, {"B", "C"},
+		{"A", "B", "C"},
+	}, res)
+
+	res2 := ro.ToSlice(ro.Powerset([]string{}))
+	assert.Equal(t, [][]string{{}}, res2)
+
+	res3 := [][]string{}
+	for v := range ro.Powerset([]string{"A", "B", "C"}) {
+		res3 = append(res3, v)
+		if len(res3) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, [][]string{{}, {"A"}}, res3)
+
+	assert.Len(t, ro.ToSlice(ro.Powerset(ro.ToSlice(ro.Range(0, 10, 1)))), 1024)
+}
+
+func TestPowersetSeq(t *testing.T) {
+	res := ro.ToSlice(ro.PowersetSeq(ro.FromSlice([]int{1, 2})))
+	assert.Equal(t, [][]int{{}, {1}, {2}, {1, 2}}, res)
+
+	res2 := ro.ToSlice(ro.PowersetSeq(ro.FromSlice([]int{})))
+	assert.Equal(t, [][]int{{}}, res2)
+}
+
+func TestProductN(t *testing.T) {
+	res := ro.ToSlice(ro.ProductN([]int{1, 2}, []int{3}, []int{4, 5}))
+	assert.Equal(t, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}, res)
+
+	res2 := ro.ToSlice(ro.ProductN[int]())
+	assert.Equal(t, [][]int{}, res2)
+
+	res3 := ro.ToSlice(ro.ProductN([]int{1, 2}, []int{}))
+	assert.Equal(t, [][]int{}, res3)
+
+	res4 := [][]int{}
+	for v := range ro.ProductN([]int{1, 2}, []int{3, 4}) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, [][]int{{1, 3}}, res4)
+
+	res5 := ro.ToSlice(ro.ProductN([]int{1, 2, 3}))
+	assert.Equal(t, [][]int{{1}, {2}, {3}}, res5)
+
+	// matches the two-way Product
+	pairs := ro.ToSlice(ro.ProductSlice([]int{1, 2, 3}, []int{4, 5}))
+	res6 := ro.ToSlice(ro.ProductN([]int{1, 2, 3}, []int{4, 5}))
+	assert.Len(t, res6, len(pairs))
+	for i, p := range pairs {
+		assert.Equal(t, []int{p.A, p.B}, res6[i])
+	}
+}
+
+func TestProductNInPlace(t *testing.T) {
+	res := [][]int{}
+	var prev []int
+	for v := range ro.ProductNInPlace([]int{1, 2}, []int{3}, []int{4, 5}) {
+		if prev != nil {
+			assert.Same(t, &prev[0], &v[0])
+		}
+		prev = v
+		res = append(res, slices.Clone(v))
+	}
+	assert.Equal(t, [][]int{{1, 3, 4}, {1, 3, 5}, {2, 3, 4}, {2, 3, 5}}, res)
+
+	res2 := ro.ToSlice(ro.ProductNInPlace([]int{1}, []int{}))
+	assert.Equal(t, [][]int{}, res2)
+}
+
+func TestProductRepeat(t *testing.T) {
+	res := ro.ToSlice(ro.ProductRepeat([]string{"A", "B"}, 2))
+	assert.Equal(t, [][]string{{"A", "A"}, {"A", "B"}, {"B", "A"}, {"B", "B"}}, res)
+
+	res2 := ro.ToSlice(ro.ProductRepeat([]string{"A", "B"}, 0))
+	assert.Equal(t, [][]string{}, res2)
+
+	res3 := ro.ToSlice(ro.ProductRepeat([]string{}, 2))
+	assert.Equal(t, [][]string{}, res3)
+
+	assert.Len(t, ro.ToSlice(ro.ProductRepeat([]int{0, 1, 2}, 4)), 81)
+
+	res4 := [][]string{}
+	for v := range ro.ProductRepeatInPlace([]string{"A", "B"}, 3) {
+		res4 = append(res4, slices.Clone(v))
+	}
+	assert.Equal(t, [][]string{
+		{"A", "A", "A"}, {"A", "A", "B"}, {"A", "B", "A"}, {"A", "B", "B"},
+		{"B", "A", "A"}, {"B", "A", "B"}, {"B", "B", "A"}, {"B", "B", "B"},
+	}, res4)
+}
+
+func BenchmarkProductN(b *testing.B) {
+	arr := ro.ToSlice(ro.Range(0, 10, 1))
+	b.Run("alloc", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for range ro.ProductRepeat(arr, 4) {
+			}
+		}
+	})
+	b.Run("in-place", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for range ro.ProductRepeatInPlace(arr, 4) {
+			}
+		}
+	})
+}
+
+func TestDiagonalProduct(t *testing.T) {
+	res := ro.ToSlice(ro.Limit(ro.DiagonalProduct(ro.Count(0, 1), ro.Count(0, 1)), 10))
+	assert.Equal(t, []lo.Tuple2[int, int]{
+		{A: 0, B: 0},
+		{A: 0, B: 1}, {A: 1, B: 0},
+		{A: 0, B: 2}, {A: 1, B: 1}, {A: 2, B: 0},
+		{A: 0, B: 3}, {A: 1, B: 2}, {A: 2, B: 1}, {A: 3, B: 0},
+	}, res)
+
+	// finite sequences yield every pair exactly once
+	res2 := ro.ToSlice(ro.DiagonalProduct(ro.FromSlice([]int{1, 2, 3}), ro.FromSlice([]string{"a", "b"})))
+	assert.ElementsMatch(t, ro.ToSlice(ro.ProductSlice([]int{1, 2, 3}, []string{"a", "b"})), res2)
+	assert.Equal(t, []lo.Tuple2[int, string]{
+		{A: 1, B: "a"},
+		{A: 1, B: "b"}, {A: 2, B: "a"},
+		{A: 2, B: "b"}, {A: 3, B: "a"},
+		{A: 3, B: "b"},
+	}, res2)
+
+	// a finite sequence paired with an infinite one
+	found := false
+	for v := range ro.DiagonalProduct(ro.Cycle(ro.FromSlice([]string{"x", "y"})), ro.FromSlice([]int{1, 2})) {
+		if v.A == "y" && v.B == 2 {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found)
+
+	found2 := false
+	for v := range ro.DiagonalProduct(ro.Count(0, 1), ro.Count(0, 1)) {
+		if v.A == 20 && v.B == 30 {
+			found2 = true
+			break
+		}
+	}
+	assert.True(t, found2)
+
+	res3 := ro.ToSlice(ro.DiagonalProduct(ro.FromSlice([]int{}), ro.Count(0, 1)))
+	assert.Equal(t, []lo.Tuple2[int, int]{}, res3)
+
+	res4 := ro.ToSlice(ro.DiagonalProduct(ro.Count(0, 1), ro.FromSlice([]int{})))
+	assert.Equal(t, []lo.Tuple2[int, int]{}, res4)
+}
+
+func TestMultisetPermutations(t *testing.T) {
+	arr := []int{2, 1, 1}
+	res := ro.ToSlice(ro.MultisetPermutations(arr))
+	assert.Equal(t, [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}}, res)
+	assert.Equal(t, []int{2, 1, 1}, arr)
+
+	// distinct elements yield the same permutations as Permutations
+	res2 := ro.ToSlice(ro.MultisetPermutations([]string{"A", "B", "C"}))
+	assert.Equal(t, ro.ToSlice(ro.Permutations([]string{"A", "B", "C"}, 3)), res2)
+
+	// 8! / (2! * 3! * 3!) = 560
+	res3 := ro.ToSlice(ro.MultisetPermutations([]int{1, 1, 2, 2, 2, 3, 3, 3}))
+	assert.Len(t, res3, 560)
+	assert.Len(t, lo.UniqBy(res3, func(p []int) [8]int { return [8]int(p) }), 560)
+
+	res4 := ro.ToSlice(ro.MultisetPermutations([]int{}))
+	assert.Equal(t, [][]int{{}}, res4)
+
+	res5 := ro.ToSlice(ro.MultisetPermutations([]int{1, 1, 1}))
+	assert.Equal(t, [][]int{{1, 1, 1}}, res5)
+
+	res6 := [][]int{}
+	for v := range ro.MultisetPermutations([]int{1, 1, 2}) {
+		res6 = append(res6, v)
+		break
+	}
+	assert.Equal(t, [][]int{{1, 1, 2}}, res6)
+}
+
+func TestMultisetPermutationsFunc(t *testing.T) {
+	type card struct {
+		rank int
+		suit string
+	}
+	byRank := func(a, b card) int { return a.rank - b.rank }
+	res := ro.ToSlice(ro.MultisetPermutationsFunc([]card{{2, "hearts"}, {1, "spades"}, {1, "clubs"}}, byRank))
+	ranks := [][]int{}
+	for _, p := range res {
+		ranks = append(ranks, lo.Map(p, func(c card, _ int) int { return c.rank }))
+	}
+	assert.Equal(t, [][]int{{1, 1, 2}, {1, 2, 1}, {2, 1, 1}}, ranks)
+
+	res2 := ro.ToSlice(ro.MultisetPermutationsFunc([]card{}, byRank))
+	assert.Equal(t, [][]card{{}}, res2)
+}
+
+func TestDerangements(t *testing.T) {
+	res := ro.ToSlice(ro.Derangements([]string{"A", "B", "C"}))
+	assert.Equal(t, [][]string{{"B", "C", "A"}, {"C", "A", "B"}}, res)
+
+	res2 := ro.ToSlice(ro.Derangements([]string{"A"}))
+	assert.Equal(t, [][]string{}, res2)
+
+	res3 := ro.ToSlice(ro.Derangements([]string{}))
+	assert.Equal(t, [][]string{{}}, res3)
+
+	res4 := [][]string{}
+	for v := range ro.Derangements([]string{"A", "B", "C"}) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, [][]string{{"B", "C", "A"}}, res4)
+
+	// repeated elements are identified by their position
+	res5 := ro.ToSlice(ro.Derangements([]int{1, 1}))
+	assert.Equal(t, [][]int{{1, 1}}, res5)
+
+	derangements := []int{1, 0, 1, 2, 9, 44, 265, 1854}
+	for n, expected := range derangements {
+		arr := ro.ToSlice(ro.Range(0, n, 1))
+		res := ro.ToSlice(ro.Derangements(arr))
+		assert.Len(t, res, expected, "n=%d", n)
+		filtered := ro.ToSlice(ro.Filter(ro.Permutations(arr, n), func(p []int) bool {
+			for i, v := range p {
+				if i == v {
+					return false
+				}
+			}
+			return true
+		}))
+		if n > 0 {
+			assert.Equal(t, filtered, res)
+		}
+	}
+}
+
+func TestPermutationsWhere(t *testing.T) {
+	// 1 cannot be first, and 3 cannot be last
+	res := ro.ToSlice(ro.PermutationsWhere([]int{1, 2, 3}, func(pos int, v int) bool {
+		return !(pos == 0 && v == 1) && !(pos == 2 && v == 3)
+	}))
+	assert.Equal(t, [][]int{{2, 3, 1}, {3, 1, 2}, {3, 2, 1}}, res)
+
+	res2 := ro.ToSlice(ro.PermutationsWhere([]int{1, 2, 3}, func(int, int) bool { return false }))
+	assert.Equal(t, [][]int{}, res2)
+
+	res3 := ro.ToSlice(ro.PermutationsWhere([]int{1, 2, 3}, func(int, int) bool { return true }))
+	assert.Equal(t, ro.ToSlice(ro.Permutations([]int{1, 2, 3}, 3)), res3)
+
+	// pruning avoids exploring every candidate permutation
+	calls := 0
+	res4 := ro.ToSlice(ro.PermutationsWhere(ro.ToSlice(ro.Range(0, 10, 1)), func(pos int, v int) bool {
+		calls++
+		return pos == v
+	}))
+	assert.Equal(t, [][]int{ro.ToSlice(ro.Range(0, 10, 1))}, res4)
+	assert.Less(t, calls, 100)
+
+	res5 := [][]int{}
+	for v := range ro.PermutationsWhere([]int{1, 2, 3}, func(int, int) bool { return true }) {
+		res5 = append(res5, v)
+		break
+	}
+	assert.Equal(t, [][]int{{1, 2, 3}}, res5)
+}
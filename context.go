@@ -0,0 +1,70 @@
+package ro
+
+import (
+	"context"
+	"iter"
+
+	"github.com/samber/lo"
+)
+
+// WithContext returns an iterator that yields the elements of the sequence until ctx is done.
+// Elements are yielded with a nil error. Once ctx is done, the iterator yields a single zero value
+// along with ctx.Err() and stops.
+//
+// The context is checked before each element is yielded, so a sequence blocked
+// while producing its next element is not interrupted.
+func WithContext[T any](ctx context.Context, seq iter.Seq[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		if err := ctx.Err(); err != nil {
+			yield(zero, err)
+			return
+		}
+		for v := range seq {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			if !yield(v, nil) {
+				return
+			}
+		}
+	}
+}
+
+// CountContext returns an iterator starting from start and incrementing by step until ctx is done.
+// See WithContext for how cancellation is reported.
+func CountContext[T intType](ctx context.Context, start, step T) iter.Seq2[T, error] {
+	return WithContext(ctx, Count(start, step))
+}
+
+// RepeatContext returns an iterator that yields elem until ctx is done.
+// See WithContext for how cancellation is reported.
+func RepeatContext[T intType](ctx context.Context, elem T) iter.Seq2[T, error] {
+	return WithContext(ctx, Repeat(elem))
+}
+
+// CycleSliceContext returns an iterator that cycles repeatedly through the elements of the slice until ctx is done.
+// See WithContext for how cancellation is reported.
+func CycleSliceContext[T any](ctx context.Context, arr []T) iter.Seq2[T, error] {
+	return WithContext(ctx, CycleSlice(arr))
+}
+
+// CycleContext returns an iterator that cycles repeatedly through the elements of sequence until ctx is done.
+// See WithContext for how cancellation is reported.
+func CycleContext[T any](ctx context.Context, seq iter.Seq[T]) iter.Seq2[T, error] {
+	return WithContext(ctx, Cycle(seq))
+}
+
+// ZipContext returns an iterator that yields the elements of seq1 and seq2 as a tuple until ctx is done.
+// See WithContext for how cancellation is reported.
+func ZipContext[U, V any](ctx context.Context, seq1 iter.Seq[U], seq2 iter.Seq[V]) iter.Seq2[lo.Tuple2[U, V], error] {
+	return WithContext(ctx, Zip(seq1, seq2))
+}
+
+// ZipFillContext returns an iterator that yields the elements of seq1 and seq2 as a tuple, padding missing values as necessary,
+// until ctx is done.
+// See WithContext for how cancellation is reported.
+func ZipFillContext[U, V any](ctx context.Context, seq1 iter.Seq[U], seq2 iter.Seq[V], fillU U, fillV V) iter.Seq2[lo.Tuple2[U, V], error] {
+	return WithContext(ctx, ZipFill(seq1, seq2, fillU, fillV))
+}
@@ -0,0 +1,174 @@
+package ro_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContext(t *testing.T) {
+	res := []int{}
+	for v, err := range ro.WithContext(context.Background(), ro.FromSlice([]int{1, 2, 3})) {
+		assert.NoError(t, err)
+		res = append(res, v)
+	}
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res2 := []int{}
+	errs := []error{}
+	for v, err := range ro.WithContext(ctx, ro.FromSlice([]int{1, 2, 3})) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res2 = append(res2, v)
+		cancel()
+	}
+	assert.Equal(t, []int{1}, res2)
+	assert.Equal(t, []error{context.Canceled}, errs)
+
+	res3 := []int{}
+	errs3 := []error{}
+	for v, err := range ro.WithContext(ctx, ro.FromSlice([]int{1, 2, 3})) {
+		if err != nil {
+			errs3 = append(errs3, err)
+			continue
+		}
+		res3 = append(res3, v)
+	}
+	assert.Equal(t, []int{}, res3)
+	assert.Equal(t, []error{context.Canceled}, errs3)
+
+	res4 := []int{}
+	for v := range ro.WithContext(context.Background(), ro.FromSlice([]int{1, 2, 3})) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res4)
+}
+
+func TestCountContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res := []int{}
+	var lastErr error
+	for v, err := range ro.CountContext(ctx, 1, 2) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		res = append(res, v)
+		if len(res) == 3 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []int{1, 3, 5}, res)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestRepeatContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res := []int{}
+	var lastErr error
+	for v, err := range ro.RepeatContext(ctx, 7) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		res = append(res, v)
+		if len(res) == 2 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []int{7, 7}, res)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestCycleSliceContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res := []string{}
+	var lastErr error
+	for v, err := range ro.CycleSliceContext(ctx, []string{"a", "b"}) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		res = append(res, v)
+		if len(res) == 3 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "a"}, res)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestCycleContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res := []string{}
+	var lastErr error
+	for v, err := range ro.CycleContext(ctx, ro.FromSlice([]string{"a", "b"})) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		res = append(res, v)
+		if len(res) == 3 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "a"}, res)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
+
+func TestZipContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res := []lo.Tuple2[int, int]{}
+	var lastErr error
+	for v, err := range ro.ZipContext(ctx, ro.Count(0, 1), ro.Count(10, 1)) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		res = append(res, v)
+		if len(res) == 2 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []lo.Tuple2[int, int]{{A: 0, B: 10}, {A: 1, B: 11}}, res)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+
+	res2 := []lo.Tuple2[int, int]{}
+	for v, err := range ro.ZipContext(context.Background(), ro.FromSlice([]int{1, 2}), ro.FromSlice([]int{3, 4})) {
+		assert.NoError(t, err)
+		res2 = append(res2, v)
+	}
+	assert.Equal(t, []lo.Tuple2[int, int]{{A: 1, B: 3}, {A: 2, B: 4}}, res2)
+}
+
+func TestZipFillContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	res := []lo.Tuple2[int, int]{}
+	var lastErr error
+	for v, err := range ro.ZipFillContext(ctx, ro.FromSlice([]int{1}), ro.Count(10, 1), -1, -2) {
+		if err != nil {
+			lastErr = err
+			break
+		}
+		res = append(res, v)
+		if len(res) == 2 {
+			cancel()
+		}
+	}
+	assert.Equal(t, []lo.Tuple2[int, int]{{A: 1, B: 10}, {A: -1, B: 11}}, res)
+	assert.ErrorIs(t, lastErr, context.Canceled)
+}
@@ -44,7 +44,10 @@ func TestAll(t *testing.T) {
 		t,
 		[]int{
 			1234,
-			2134,
+			1235,
+			1243,
+			1245,
+			1253,
 		},
 		res,
 	)
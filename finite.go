@@ -1,17 +1,126 @@
 package ro
 
-import "iter"
+import (
+	"iter"
+	"math"
+)
 
-// Range returns an iterator that yields integers from start to end (exclusive) by step
+// Range returns an iterator that yields integers from start to end (exclusive) by step.
+// A negative step counts down from start to end.
+//
+// The iterator stops instead of wrapping around when the next value would overflow T.
+// If step is 0, the iterator is empty.
 func Range[T intType](start, end, step T) iter.Seq[T] {
 	if step == 0 {
 		return empty[T]()
 	}
+	return rangeChecked(start, step, func(i T) bool {
+		if step > 0 {
+			return i < end
+		}
+		return i > end
+	})
+}
+
+// RangeInclusive returns an iterator that yields integers from start to end (inclusive) by step.
+// A negative step counts down from start to end.
+//
+// The iterator stops instead of wrapping around when the next value would overflow T,
+// so ranges ending at the bounds of T terminate.
+// If step is 0, the iterator is empty.
+func RangeInclusive[T intType](start, end, step T) iter.Seq[T] {
+	if step == 0 {
+		return empty[T]()
+	}
+	return rangeChecked(start, step, func(i T) bool {
+		if step > 0 {
+			return i <= end
+		}
+		return i >= end
+	})
+}
+
+// rangeChecked yields values from start by step while cond holds, stopping when the next value would overflow T
+func rangeChecked[T intType](start, step T, cond func(T) bool) iter.Seq[T] {
 	return func(yield func(T) bool) {
-		for i := start; i < end; i += step {
+		for i, ok := start, true; ok && cond(i); i, ok = addChecked(i, step) {
 			if !yield(i) {
-				break
+				return
+			}
+		}
+	}
+}
+
+func isFinite[T floatType](vals ...T) bool {
+	for _, v := range vals {
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Arange returns an iterator that yields floats from start to stop (exclusive) by step.
+// A negative step counts down from start to stop.
+//
+// The i-th value is computed as start + i*step instead of by repeated addition, so rounding errors do not accumulate.
+// If any argument is NaN or infinite, or step is 0, the iterator is empty.
+func Arange[T floatType](start, stop, step T) iter.Seq[T] {
+	if step == 0 || !isFinite(start, stop, step) {
+		return empty[T]()
+	}
+	return func(yield func(T) bool) {
+		for i := 0; ; i++ {
+			v := T(float64(start) + float64(i)*float64(step))
+			if (step > 0 && v >= stop) || (step < 0 && v <= stop) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Linspace returns an iterator that yields n evenly spaced floats from start to stop.
+// If endpoint is true, stop is the last value yielded, otherwise it is excluded.
+//
+// If n <= 0, or start or stop is NaN or infinite, the iterator is empty.
+func Linspace[T floatType](start, stop T, n int, endpoint bool) iter.Seq[T] {
+	if n <= 0 || !isFinite(start, stop) {
+		return empty[T]()
+	}
+	div := n
+	if endpoint {
+		div = n - 1
+	}
+	return func(yield func(T) bool) {
+		for i := 0; i < n; i++ {
+			var v T
+			switch {
+			case endpoint && i == n-1 && n > 1:
+				v = stop
+			case div == 0:
+				v = start
+			default:
+				v = T(float64(start) + (float64(stop)-float64(start))*float64(i)/float64(div))
+			}
+			if !yield(v) {
+				return
 			}
 		}
 	}
 }
+
+// Logspace returns an iterator that yields n floats evenly spaced on a log scale, from base**start to base**stop.
+// If endpoint is true, base**stop is the last value yielded, otherwise it is excluded.
+//
+// If n <= 0, base <= 0, or any argument is NaN or infinite, the iterator is empty.
+func Logspace[T floatType](start, stop T, n int, endpoint bool, base T) iter.Seq[T] {
+	if base <= 0 || !isFinite(base) {
+		return empty[T]()
+	}
+	return Apply(Linspace(start, stop, n, endpoint), func(x T) T {
+		return T(math.Pow(float64(base), float64(x)))
+	})
+}
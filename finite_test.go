@@ -1,6 +1,7 @@
 package ro_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/alexandreLamarre/ro"
@@ -39,3 +40,156 @@ func TestRange(t *testing.T) {
 	}
 	assert.Equal(t, []int8{1}, r5)
 }
+
+func TestRangeDescending(t *testing.T) {
+	res := ro.ToSlice(ro.Range(10, 0, -3))
+	assert.Equal(t, []int{10, 7, 4, 1}, res)
+
+	res2 := ro.ToSlice(ro.Range(0, 10, -1))
+	assert.Equal(t, []int{}, res2)
+
+	res3 := ro.ToSlice(ro.Range(10, 0, 1))
+	assert.Equal(t, []int{}, res3)
+
+	res4 := ro.ToSlice(ro.Range(int8(-120), int8(-128), int8(-5)))
+	assert.Equal(t, []int8{-120, -125}, res4)
+}
+
+func TestRangeOverflow(t *testing.T) {
+	res := ro.ToSlice(ro.Range(int8(120), int8(127), int8(5)))
+	assert.Equal(t, []int8{120, 125}, res)
+
+	res2 := ro.ToSlice(ro.Range(uint8(250), uint8(255), uint8(4)))
+	assert.Equal(t, []uint8{250, 254}, res2)
+
+	res3 := ro.ToSlice(ro.Range(int8(0), int8(127), int8(100)))
+	assert.Equal(t, []int8{0, 100}, res3)
+
+	res4 := ro.ToSlice(ro.Range(int8(-100), int8(-128), int8(-100)))
+	assert.Equal(t, []int8{-100}, res4)
+}
+
+func TestRangeInclusive(t *testing.T) {
+	res := ro.ToSlice(ro.RangeInclusive(1, 9, 2))
+	assert.Equal(t, []int{1, 3, 5, 7, 9}, res)
+
+	res2 := ro.ToSlice(ro.RangeInclusive(5, 1, -2))
+	assert.Equal(t, []int{5, 3, 1}, res2)
+
+	res3 := ro.ToSlice(ro.RangeInclusive(1, 1, 1))
+	assert.Equal(t, []int{1}, res3)
+
+	res4 := ro.ToSlice(ro.RangeInclusive(1, 6, 0))
+	assert.Equal(t, []int{}, res4)
+
+	// ranges ending at the bounds of the type terminate
+	res5 := ro.ToSlice(ro.RangeInclusive(int8(125), int8(127), int8(1)))
+	assert.Equal(t, []int8{125, 126, 127}, res5)
+
+	res7 := ro.ToSlice(ro.RangeInclusive(int8(-126), int8(-128), int8(-1)))
+	assert.Equal(t, []int8{-126, -127, -128}, res7)
+
+	res8 := ro.ToSlice(ro.RangeInclusive(uint8(0), uint8(255), uint8(1)))
+	assert.Len(t, res8, 256)
+
+	res9 := []int{}
+	for v := range ro.RangeInclusive(1, 9, 2) {
+		res9 = append(res9, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res9)
+}
+
+func TestArange(t *testing.T) {
+	res := ro.ToSlice(ro.Arange(0.0, 1.0, 0.25))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75}, res)
+
+	res2 := ro.ToSlice(ro.Arange(1.0, 0.0, -0.25))
+	assert.Equal(t, []float64{1, 0.75, 0.5, 0.25}, res2)
+
+	res3 := ro.ToSlice(ro.Arange(0.0, 1.0, -0.25))
+	assert.Equal(t, []float64{}, res3)
+
+	res4 := ro.ToSlice(ro.Arange(0.0, 1.0, 0))
+	assert.Equal(t, []float64{}, res4)
+
+	// repeated addition of 0.1 drifts to 0.30000000000000004, 0.4, 0.5, 0.6, 0.7, 0.7999999999999999, ...
+	res5 := ro.ToSlice(ro.Arange(0.0, 1.0, 0.1))
+	assert.Len(t, res5, 10)
+	for i, v := range res5 {
+		assert.Equal(t, float64(i)*0.1, v)
+	}
+
+	// 1 + 3*0.1 rounds above 1.3, so it is excluded
+	res6 := ro.ToSlice(ro.Arange(1.0, 1.3, 0.1))
+	assert.Len(t, res6, 3)
+
+	res7 := ro.ToSlice(ro.Arange(float32(0), float32(1), float32(0.5)))
+	assert.Equal(t, []float32{0, 0.5}, res7)
+
+	nan, inf := math.NaN(), math.Inf(1)
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Arange(nan, 1, 0.1)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Arange(0, nan, 0.1)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Arange(0, 1, nan)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Arange(0, inf, 0.1)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Arange(-inf, 0, 0.1)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Arange(0, 1, inf)))
+
+	res8 := []float64{}
+	for v := range ro.Arange(0.0, 1.0, 0.25) {
+		res8 = append(res8, v)
+		break
+	}
+	assert.Equal(t, []float64{0}, res8)
+}
+
+func TestLinspace(t *testing.T) {
+	res := ro.ToSlice(ro.Linspace(0.0, 1.0, 5, true))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75, 1}, res)
+
+	res2 := ro.ToSlice(ro.Linspace(0.0, 1.0, 4, false))
+	assert.Equal(t, []float64{0, 0.25, 0.5, 0.75}, res2)
+
+	res3 := ro.ToSlice(ro.Linspace(1.0, -1.0, 3, true))
+	assert.Equal(t, []float64{1, 0, -1}, res3)
+
+	res4 := ro.ToSlice(ro.Linspace(2.0, 3.0, 1, true))
+	assert.Equal(t, []float64{2}, res4)
+
+	res5 := ro.ToSlice(ro.Linspace(2.0, 3.0, 0, true))
+	assert.Equal(t, []float64{}, res5)
+
+	// the endpoint is exact even when the step is not representable
+	res6 := ro.ToSlice(ro.Linspace(0.0, 0.3, 4, true))
+	assert.Len(t, res6, 4)
+	assert.Equal(t, 0.3, res6[3])
+
+	nan, inf := math.NaN(), math.Inf(1)
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Linspace(nan, 1, 3, true)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Linspace(0, inf, 3, true)))
+
+	res7 := []float32{}
+	for v := range ro.Linspace(float32(0), float32(1), 3, true) {
+		res7 = append(res7, v)
+		break
+	}
+	assert.Equal(t, []float32{0}, res7)
+}
+
+func TestLogspace(t *testing.T) {
+	res := ro.ToSlice(ro.Logspace(0.0, 3.0, 4, true, 10))
+	assert.Equal(t, []float64{1, 10, 100, 1000}, res)
+
+	res2 := ro.ToSlice(ro.Logspace(0.0, 3.0, 3, false, 2))
+	assert.Equal(t, []float64{1, 2, 4}, res2)
+
+	res3 := ro.ToSlice(ro.Logspace(2.0, 0.0, 3, true, 2))
+	assert.Equal(t, []float64{4, 2, 1}, res3)
+
+	nan, inf := math.NaN(), math.Inf(1)
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Logspace(0.0, 3, 4, true, 0)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Logspace(0.0, 3, 4, true, -2)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Logspace(0.0, 3, 4, true, nan)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Logspace(0.0, 3, 4, true, inf)))
+	assert.Equal(t, []float64{}, ro.ToSlice(ro.Logspace(nan, 3, 4, true, 10)))
+}
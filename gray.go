@@ -0,0 +1,57 @@
+package ro
+
+import (
+	"iter"
+	"slices"
+)
+
+// GrayCodes returns an iterator that yields the n-bit binary reflected Gray code, where consecutive codes differ by a single bit.
+// Each code is yielded as a slice of n bits, least significant first, along with the position of the bit flipped
+// from the previous code, or -1 for the first code.
+// e.g. 2 yields (-1, [0, 0]), (0, [1, 0]), (1, [1, 1]), (0, [0, 1]).
+// If n < 0, the empty iterator is returned.
+//
+// Each yielded code is a newly allocated slice.
+func GrayCodes(n int) iter.Seq2[int, []int] {
+	return NaryGrayCodes(n, 2)
+}
+
+// NaryGrayCodes returns an iterator that yields the n-digit reflected Gray code in the given base, where consecutive codes
+// differ by a single digit changing by one.
+// Each code is yielded as a slice of n digits, least significant first, along with the position of the digit changed
+// from the previous code, or -1 for the first code.
+// If n < 0 or base < 2, the empty iterator is returned.
+//
+// Each yielded code is a newly allocated slice.
+func NaryGrayCodes(n, base int) iter.Seq2[int, []int] {
+	if n < 0 || base < 2 {
+		return func(_ func(int, []int) bool) {}
+	}
+	return func(yield func(int, []int) bool) {
+		digits := make([]int, n)
+		dirs := make([]int, n)
+		for i := range dirs {
+			dirs[i] = 1
+		}
+		if !yield(-1, slices.Clone(digits)) {
+			return
+		}
+		for {
+			// the lowest digit that can move in its direction changes, and every lower digit reverses
+			j := 0
+			for ; j < n; j++ {
+				if d := digits[j] + dirs[j]; d >= 0 && d < base {
+					break
+				}
+				dirs[j] = -dirs[j]
+			}
+			if j == n {
+				return
+			}
+			digits[j] += dirs[j]
+			if !yield(j, slices.Clone(digits)) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package ro_test
+
+import (
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrayCodes(t *testing.T) {
+	res := iter2ToTuple(ro.GrayCodes(2))
+	assert.Equal(t, []lo.Tuple2[int, []int]{
+		{A: -1, B: []int{0, 0}},
+		{A: 0, B: []int{1, 0}},
+		{A: 1, B: []int{1, 1}},
+		{A: 0, B: []int{0, 1}},
+	}, res)
+
+	res2 := iter2ToTuple(ro.GrayCodes(0))
+	assert.Equal(t, []lo.Tuple2[int, []int]{{A: -1, B: []int{}}}, res2)
+
+	res3 := iter2ToTuple(ro.GrayCodes(-1))
+	assert.Equal(t, []lo.Tuple2[int, []int]{}, res3)
+
+	// the flipped bit of the i-th code is the number of trailing zeros of i,
+	// and the i-th code is i ^ (i >> 1)
+	i := 0
+	for bit, code := range ro.GrayCodes(8) {
+		if i > 0 {
+			expected := 0
+			for (i>>expected)&1 == 0 {
+				expected++
+			}
+			assert.Equal(t, expected, bit)
+		}
+		value := 0
+		for b := len(code) - 1; b >= 0; b-- {
+			value = value<<1 | code[b]
+		}
+		assert.Equal(t, i^(i>>1), value)
+		i++
+	}
+	assert.Equal(t, 256, i)
+
+	res4 := []int{}
+	for bit := range ro.GrayCodes(3) {
+		res4 = append(res4, bit)
+		if len(res4) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{-1, 0}, res4)
+}
+
+func TestNaryGrayCodes(t *testing.T) {
+	codes := [][]int{}
+	for _, code := range ro.NaryGrayCodes(2, 3) {
+		codes = append(codes, code)
+	}
+	assert.Equal(t, [][]int{
+		{0, 0}, {1, 0}, {2, 0},
+		{2, 1}, {1, 1}, {0, 1},
+		{0, 2}, {1, 2}, {2, 2},
+	}, codes)
+
+	assert.Equal(t, []lo.Tuple2[int, []int]{}, iter2ToTuple(ro.NaryGrayCodes(2, 1)))
+
+	// every code is visited exactly once, and consecutive codes differ by one in a single digit
+	codes2 := iter2ToTuple(ro.NaryGrayCodes(3, 4))
+	assert.Len(t, codes2, 64)
+	assert.Len(t, lo.UniqBy(codes2, func(c lo.Tuple2[int, []int]) [3]int { return [3]int(c.B) }), 64)
+	for i := 1; i < len(codes2); i++ {
+		prev, cur := codes2[i-1].B, codes2[i].B
+		changed := codes2[i].A
+		for d := range cur {
+			if d == changed {
+				assert.Contains(t, []int{-1, 1}, cur[d]-prev[d])
+			} else {
+				assert.Equal(t, prev[d], cur[d])
+			}
+		}
+	}
+}
@@ -13,6 +13,12 @@ func Count[T intType](start, step T) iter.Seq[T] {
 	}
 }
 
+// CountChecked returns an iterator starting from start and incrementing by step, like Count.
+// Instead of wrapping around, the sequence ends after the last value before the bounds of T would be exceeded.
+func CountChecked[T intType](start, step T) iter.Seq[T] {
+	return rangeChecked(start, step, func(T) bool { return true })
+}
+
 // Repeat returns an infinite iterator that yields elem indefinitely
 func Repeat[T intType](elem T) iter.Seq[T] {
 	return Count(elem, 0)
@@ -40,18 +46,23 @@ func CycleSlice[T any](arr []T) iter.Seq[T] {
 }
 
 // Cycle returns an infinite iterator that cycles repeatedly through the elements of sequence
+// If the sequence is empty, the empty iterator is returned.
+//
+// The sequence is only consumed once, its elements are cached (see Memoize) and replayed on each cycle
 func Cycle[T any](seq iter.Seq[T]) iter.Seq[T] {
-	done := false
 	return func(yield func(T) bool) {
+		cached, stop := Memoize(seq)
+		defer stop()
 		for {
-			for v := range seq {
+			n := 0
+			for v := range cached {
+				n++
 				if !yield(v) {
-					done = true
-					break
+					return
 				}
 			}
-			if done {
-				break
+			if n == 0 {
+				return
 			}
 		}
 	}
@@ -42,6 +42,26 @@ func TestCount(t *testing.T) {
 	assert.Equal(t, []int8{1, 2, 3}, res3)
 }
 
+func TestCountChecked(t *testing.T) {
+	res := ro.ToSlice(ro.CountChecked(int8(100), int8(10)))
+	assert.Equal(t, []int8{100, 110, 120}, res)
+
+	res2 := ro.ToSlice(ro.CountChecked(int8(-100), int8(-10)))
+	assert.Equal(t, []int8{-100, -110, -120}, res2)
+
+	res3 := ro.ToSlice(ro.CountChecked(uint8(250), uint8(1)))
+	assert.Equal(t, []uint8{250, 251, 252, 253, 254, 255}, res3)
+
+	res4 := ro.ToSlice(ro.CountChecked(uint8(255), uint8(1)))
+	assert.Equal(t, []uint8{255}, res4)
+
+	res5 := ro.ToSlice(ro.Limit(ro.CountChecked(1, 4), 3))
+	assert.Equal(t, []int{1, 5, 9}, res5)
+
+	res6 := ro.ToSlice(ro.Limit(ro.CountChecked(int8(127), int8(0)), 3))
+	assert.Equal(t, []int8{127, 127, 127}, res6)
+}
+
 func TestCycleSlice(t *testing.T) {
 	s := []string{"a", "b", "c"}
 	res := []string{}
@@ -81,18 +101,33 @@ func TestCycle(t *testing.T) {
 	}
 	assert.Equal(t, []string{"a", "b", "c", "a", "b", "c"}, res)
 
-	// TODO : requires a redesign of the implementation of CycleIter to prevent infinite loops
-	// nan := []int{}
-	// res2 := []int{}
-	// n = 10
-	// for v := range ro.CycleIter(ro.SeqAsIter(nan)) {
-	// 	res2 = append(res2, v)
-	// 	n--
-	// 	if n == 0 {
-	// 		break
-	// 	}
-	// }
-	// assert.Equal(t, []int{}, res2)
+	nan := []int{}
+	res2 := []int{}
+	n = 10
+	for v := range ro.Cycle(ro.FromSlice(nan)) {
+		res2 = append(res2, v)
+		n--
+		if n == 0 {
+			break
+		}
+	}
+	assert.Equal(t, []int{}, res2)
+
+	// single-use sequences are replayed from the cache
+	ch := make(chan string, 2)
+	ch <- "a"
+	ch <- "b"
+	close(ch)
+	res3 := []string{}
+	n = 5
+	for v := range ro.Cycle(ro.FromChan(ch)) {
+		res3 = append(res3, v)
+		n--
+		if n == 0 {
+			break
+		}
+	}
+	assert.Equal(t, []string{"a", "b", "a", "b", "a"}, res3)
 }
 
 func TestRepeat(t *testing.T) {
@@ -0,0 +1,98 @@
+package ro
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrMemoizeLimit is returned when a memoized sequence yields more elements than its cache can hold
+var ErrMemoizeLimit = errors.New("memoize: cache limit exceeded")
+
+// memo lazily caches the elements pulled from a sequence
+type memo[T any] struct {
+	seq       iter.Seq[T]
+	next      func() (T, bool)
+	stop      func()
+	exhausted bool
+	overflow  bool
+	cache     []T
+	// limit is the maximum number of cached elements, or -1 when unbounded
+	limit int
+}
+
+// at returns the i-th element of the sequence, pulling it from the source if it is not cached yet
+func (m *memo[T]) at(i int) (T, bool, error) {
+	var zero T
+	if i < len(m.cache) {
+		return m.cache[i], true, nil
+	}
+	if m.exhausted {
+		return zero, false, nil
+	}
+	if m.overflow {
+		return zero, false, ErrMemoizeLimit
+	}
+	if m.next == nil {
+		m.next, m.stop = iter.Pull(m.seq)
+	}
+	v, ok := m.next()
+	if !ok {
+		m.close()
+		return zero, false, nil
+	}
+	if m.limit >= 0 && len(m.cache) >= m.limit {
+		m.overflow = true
+		return zero, false, ErrMemoizeLimit
+	}
+	m.cache = append(m.cache, v)
+	return v, true, nil
+}
+
+// close stops the source, the cached elements can still be replayed
+func (m *memo[T]) close() {
+	m.exhausted = true
+	if m.stop != nil {
+		m.stop()
+	}
+}
+
+// Memoize returns an iterator that yields the elements of the sequence, caching them the first time they are read.
+// Ranging over the iterator again replays the cached elements, then continues into the elements
+// not yet read from the sequence, which is only ever consumed once.
+//
+// The returned stop function releases the sequence, after which only the cached elements are replayed.
+// It must be called if the sequence is not read until it is exhausted, like with iter.Pull.
+// The returned iterator must be consumed from a single goroutine.
+func Memoize[T any](seq iter.Seq[T]) (iter.Seq[T], func()) {
+	m := &memo[T]{seq: seq, limit: -1}
+	return func(yield func(T) bool) {
+		for i := 0; ; i++ {
+			v, ok, _ := m.at(i)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}, m.close
+}
+
+// MemoizeLimit returns an iterator that yields the elements of the sequence like Memoize,
+// caching at most limit elements. Once the sequence would need to cache more than limit elements,
+// the iterator yields a single zero value along with ErrMemoizeLimit and stops.
+func MemoizeLimit[T any](seq iter.Seq[T], limit int) (iter.Seq2[T, error], func()) {
+	if limit < 0 {
+		limit = 0
+	}
+	m := &memo[T]{seq: seq, limit: limit}
+	return func(yield func(T, error) bool) {
+		for i := 0; ; i++ {
+			v, ok, err := m.at(i)
+			if err != nil {
+				yield(v, err)
+				return
+			}
+			if !ok || !yield(v, nil) {
+				return
+			}
+		}
+	}, m.close
+}
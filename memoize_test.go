@@ -0,0 +1,93 @@
+package ro_test
+
+import (
+	"iter"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+// onceSeq returns an iterator over arr that can only be ranged over once, along with the number of elements it has produced
+func onceSeq[T any](arr []T) (iter.Seq[T], *int) {
+	used := false
+	produced := 0
+	return func(yield func(T) bool) {
+		if used {
+			return
+		}
+		used = true
+		for _, v := range arr {
+			produced++
+			if !yield(v) {
+				return
+			}
+		}
+	}, &produced
+}
+
+func TestMemoize(t *testing.T) {
+	src, produced := onceSeq([]int{1, 2, 3, 4})
+	seq, stop := ro.Memoize(src)
+	defer stop()
+
+	res := []int{}
+	for v := range seq {
+		res = append(res, v)
+		if len(res) == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, res)
+	assert.Equal(t, 2, *produced)
+
+	// replays the cache then continues into the unread tail
+	assert.Equal(t, []int{1, 2, 3, 4}, ro.ToSlice(seq))
+	assert.Equal(t, []int{1, 2, 3, 4}, ro.ToSlice(seq))
+	assert.Equal(t, 4, *produced)
+
+	seq2, stop2 := ro.Memoize(ro.FromSlice([]int{}))
+	defer stop2()
+	assert.Equal(t, []int{}, ro.ToSlice(seq2))
+	assert.Equal(t, []int{}, ro.ToSlice(seq2))
+
+	// after stop, only the cached elements are replayed
+	seq3, stop3 := ro.Memoize(ro.Count(0, 1))
+	res3 := []int{}
+	for v := range seq3 {
+		res3 = append(res3, v)
+		if len(res3) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 1, 2}, res3)
+	stop3()
+	assert.Equal(t, []int{0, 1, 2}, ro.ToSlice(seq3))
+}
+
+func TestMemoizeLimit(t *testing.T) {
+	seq, stop := ro.MemoizeLimit(ro.FromSlice([]int{1, 2, 3}), 3)
+	defer stop()
+	res, err := ro.TryToSlice(seq)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+	res, err = ro.TryToSlice(seq)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	seq2, stop2 := ro.MemoizeLimit(ro.FromSlice([]int{1, 2, 3}), 2)
+	defer stop2()
+	res2, err := ro.TryToSlice(seq2)
+	assert.ErrorIs(t, err, ro.ErrMemoizeLimit)
+	assert.Equal(t, []int{1, 2}, res2)
+
+	seq3, stop3 := ro.MemoizeLimit(ro.FromSlice([]int{1, 2, 3}), 2)
+	defer stop3()
+	res3 := []int{}
+	for v, err := range seq3 {
+		assert.NoError(t, err)
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res3)
+}
@@ -0,0 +1,172 @@
+package ro
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+)
+
+// ErrOverflow is returned when an integer result does not fit in its type
+var ErrOverflow = errors.New("integer overflow")
+
+// SumSlice returns the sum of the elements in the slice, or 0 if the slice is empty
+func SumSlice[T numberType](arr []T) T {
+	return Sum(FromSlice(arr))
+}
+
+// Sum returns the sum of the elements yielded by seq, or 0 if seq is empty
+//
+// This will block until the iterator is exhausted.
+func Sum[T numberType](seq iter.Seq[T]) T {
+	sum := T(0)
+	for v := range seq {
+		sum += v
+	}
+	return sum
+}
+
+// ProdSlice returns the product of the elements in the slice, or 1 if the slice is empty
+func ProdSlice[T numberType](arr []T) T {
+	return Prod(FromSlice(arr))
+}
+
+// Prod returns the product of the elements yielded by seq, or 1 if seq is empty
+//
+// This will block until the iterator is exhausted.
+func Prod[T numberType](seq iter.Seq[T]) T {
+	prod := T(1)
+	for v := range seq {
+		prod *= v
+	}
+	return prod
+}
+
+// RunningMeanSlice returns an iterator that yields the mean of the elements of the slice seen so far
+func RunningMeanSlice[T inexactType](arr []T) iter.Seq[T] {
+	return RunningMean(FromSlice(arr))
+}
+
+// RunningMean returns an iterator that yields the mean of the elements yielded by seq so far
+//
+// The mean is updated incrementally, so it does not overflow when the running sum would.
+// Integer sequences can be converted with Apply first.
+func RunningMean[T inexactType](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		mean, n := T(0), T(0)
+		for v := range seq {
+			n++
+			mean += (v - mean) / n
+			if !yield(mean) {
+				break
+			}
+		}
+	}
+}
+
+func abs[T floatType](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// AccumulateCompensatedSlice returns an iterator that yields the compensated accumulated sum of the elements in the slice,
+// see AccumulateCompensated.
+func AccumulateCompensatedSlice[T floatType](arr []T) iter.Seq[T] {
+	return AccumulateCompensated(FromSlice(arr))
+}
+
+// AccumulateCompensated returns an iterator that yields the accumulated sum of the elements yielded by seq,
+// using Kahan-Babuska-Neumaier compensated summation.
+//
+// The rounding error of each addition is tracked separately and added back to every yielded sum,
+// so the result stays accurate when adding many values or values of very different magnitudes.
+// Once the sum overflows or becomes NaN, the compensation is dropped and the uncompensated sum is yielded.
+func AccumulateCompensated[T floatType](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sum, c := T(0), T(0)
+		for v := range seq {
+			t := sum + v
+			switch {
+			case math.IsNaN(float64(t)) || math.IsInf(float64(t), 0):
+				c = 0
+			case abs(sum) >= abs(v):
+				c += (sum - t) + v
+			default:
+				c += (v - t) + sum
+			}
+			sum = t
+			if !yield(sum + c) {
+				break
+			}
+		}
+	}
+}
+
+// SumCompensatedSlice returns the compensated sum of the elements in the slice, see AccumulateCompensated.
+func SumCompensatedSlice[T floatType](arr []T) T {
+	return SumCompensated(FromSlice(arr))
+}
+
+// SumCompensated returns the compensated sum of the elements yielded by seq, or 0 if seq is empty,
+// see AccumulateCompensated.
+//
+// This will block until the iterator is exhausted.
+func SumCompensated[T floatType](seq iter.Seq[T]) T {
+	sum := T(0)
+	for v := range AccumulateCompensated(seq) {
+		sum = v
+	}
+	return sum
+}
+
+// AccumulateCheckedSlice returns an iterator that yields the accumulated sum of the elements in the slice,
+// see AccumulateChecked.
+func AccumulateCheckedSlice[T intType](arr []T) iter.Seq2[T, error] {
+	return AccumulateChecked(FromSlice(arr))
+}
+
+// AccumulateChecked returns an iterator that yields the accumulated sum of the elements yielded by seq.
+// If adding an element would overflow T, a zero value is yielded along with an error wrapping ErrOverflow,
+// which reports the element and the sum accumulated so far, and the iterator stops.
+func AccumulateChecked[T intType](seq iter.Seq[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var sum, zero T
+		i := 0
+		for v := range seq {
+			next, ok := addChecked(sum, v)
+			if !ok {
+				yield(zero, fmt.Errorf("%w: adding %v to %v at element %d", ErrOverflow, v, sum, i))
+				return
+			}
+			sum = next
+			if !yield(sum, nil) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// AccumulateSaturatingSlice returns an iterator that yields the accumulated sum of the elements in the slice,
+// see AccumulateSaturating.
+func AccumulateSaturatingSlice[T intType](arr []T) iter.Seq[T] {
+	return AccumulateSaturating(FromSlice(arr))
+}
+
+// AccumulateSaturating returns an iterator that yields the accumulated sum of the elements yielded by seq,
+// clamping the sum at the bounds of T instead of wrapping around.
+//
+// A clamped sum moves away from the bound again as soon as an element of the opposite sign is added.
+func AccumulateSaturating[T intType](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		sum := T(0)
+		for v := range seq {
+			sum = addSaturating(sum, v)
+			if !yield(sum) {
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package ro_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 10, ro.Sum(ro.FromSlice([]int{1, 2, 3, 4})))
+	assert.Equal(t, 0, ro.Sum(empty[int]()))
+	assert.Equal(t, 1.75, ro.Sum(ro.FromSlice([]float64{0.5, 0.25, 1})))
+	assert.Equal(t, complex(4, -1), ro.Sum(ro.FromSlice([]complex128{complex(1, 1), complex(3, -2)})))
+	assert.Equal(t, uint8(6), ro.SumSlice([]uint8{1, 2, 3}))
+	assert.Equal(t, float32(0), ro.SumSlice([]float32{}))
+}
+
+func TestProd(t *testing.T) {
+	assert.Equal(t, 24, ro.Prod(ro.FromSlice([]int{1, 2, 3, 4})))
+	assert.Equal(t, 1, ro.Prod(empty[int]()))
+	assert.Equal(t, 0.125, ro.Prod(ro.FromSlice([]float64{0.5, 0.25, 1})))
+	// i * i = -1
+	assert.Equal(t, complex64(-1), ro.ProdSlice([]complex64{complex(0, 1), complex(0, 1)}))
+	assert.Equal(t, int8(1), ro.ProdSlice([]int8{}))
+}
+
+func TestRunningMean(t *testing.T) {
+	res := ro.ToSlice(ro.RunningMean(ro.FromSlice([]float64{2, 4, 6, 8})))
+	assert.Equal(t, []float64{2, 3, 4, 5}, res)
+
+	res2 := ro.ToSlice(ro.RunningMean(empty[float64]()))
+	assert.Equal(t, []float64{}, res2)
+
+	res3 := ro.ToSlice(ro.RunningMeanSlice([]complex128{complex(2, 0), complex(0, 2)}))
+	assert.Equal(t, []complex128{complex(2, 0), complex(1, 1)}, res3)
+
+	// the running sum would overflow
+	res4 := ro.ToSlice(ro.RunningMeanSlice([]float64{math.MaxFloat64, math.MaxFloat64}))
+	assert.Equal(t, []float64{math.MaxFloat64, math.MaxFloat64}, res4)
+
+	res5 := ro.ToSlice(ro.RunningMean(ro.Apply(ro.Range(1, 5, 1), func(v int) float32 { return float32(v) })))
+	assert.Equal(t, []float32{1, 1.5, 2, 2.5}, res5)
+
+	res6 := []float64{}
+	for v := range ro.RunningMeanSlice([]float64{1, 2, 3}) {
+		res6 = append(res6, v)
+		break
+	}
+	assert.Equal(t, []float64{1}, res6)
+}
+
+func TestAccumulateCompensated(t *testing.T) {
+	// naive summation loses the small values entirely
+	vals := []float64{1, 1e100, 1, -1e100}
+	assert.Equal(t, []float64{1, 1e100, 1e100, 0}, ro.ToSlice(ro.AccumulateSlice(vals)))
+	assert.Equal(t, []float64{1, 1e100, 1e100, 2}, ro.ToSlice(ro.AccumulateCompensatedSlice(vals)))
+
+	res := ro.ToSlice(ro.AccumulateCompensated(empty[float32]()))
+	assert.Equal(t, []float32{}, res)
+
+	res2 := ro.ToSlice(ro.AccumulateCompensatedSlice([]float64{math.MaxFloat64, math.MaxFloat64, 1}))
+	assert.Equal(t, []float64{math.MaxFloat64, math.Inf(1), math.Inf(1)}, res2)
+
+	res3 := ro.ToSlice(ro.AccumulateCompensatedSlice([]float64{1, math.NaN(), 1}))
+	assert.Len(t, res3, 3)
+	assert.True(t, math.IsNaN(res3[2]))
+
+	res4 := []float64{}
+	for v := range ro.AccumulateCompensatedSlice([]float64{1, 2, 3}) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, []float64{1}, res4)
+}
+
+func TestSumCompensated(t *testing.T) {
+	tenths := ro.Apply(ro.Limit(ro.Repeat(1), 10), func(v int) float64 { return float64(v) / 10 })
+	assert.NotEqual(t, 1.0, ro.Sum(tenths))
+	assert.Equal(t, 1.0, ro.SumCompensated(tenths))
+
+	assert.Equal(t, float32(0), ro.SumCompensated(empty[float32]()))
+	assert.Equal(t, 2.0, ro.SumCompensatedSlice([]float64{1, 1e100, 1, -1e100}))
+}
+
+func TestAccumulateChecked(t *testing.T) {
+	res, err := ro.TryToSliceAll(ro.AccumulateCheckedSlice([]uint8{100, 100, 100, 50}))
+	assert.Equal(t, []uint8{100, 200}, res)
+	assert.ErrorIs(t, err, ro.ErrOverflow)
+	assert.EqualError(t, err, "integer overflow: adding 100 to 200 at element 2")
+
+	res2, err := ro.TryToSlice(ro.AccumulateChecked(ro.FromSlice([]int32{math.MaxInt32 - 1, 1, 1, -5})))
+	assert.Equal(t, []int32{math.MaxInt32 - 1, math.MaxInt32}, res2)
+	assert.ErrorIs(t, err, ro.ErrOverflow)
+
+	// the overflow is reported with a zero value, and the iterator stops
+	sums, errs := []int8{}, []error{}
+	for v, err := range ro.AccumulateCheckedSlice([]int8{-100, -100, 50}) {
+		sums = append(sums, v)
+		errs = append(errs, err)
+	}
+	assert.Equal(t, []int8{-100, 0}, sums)
+	assert.ErrorContains(t, errs[1], "to -100 at element 1")
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ro.ErrOverflow)
+
+	res4, err := ro.TryToSliceAll(ro.AccumulateChecked(ro.FromSlice([]int{1, 2, 3})))
+	assert.Equal(t, []int{1, 3, 6}, res4)
+	assert.NoError(t, err)
+
+	res5, err := ro.TryToSliceAll(ro.AccumulateChecked(empty[uint]()))
+	assert.Equal(t, []uint{}, res5)
+	assert.NoError(t, err)
+
+	res6 := []uint8{}
+	for v := range ro.AccumulateCheckedSlice([]uint8{1, 2, 3}) {
+		res6 = append(res6, v)
+		break
+	}
+	assert.Equal(t, []uint8{1}, res6)
+}
+
+func TestAccumulateSaturating(t *testing.T) {
+	res := ro.ToSlice(ro.AccumulateSaturatingSlice([]uint8{100, 100, 100, 0}))
+	assert.Equal(t, []uint8{100, 200, math.MaxUint8, math.MaxUint8}, res)
+
+	res2 := ro.ToSlice(ro.AccumulateSaturating(ro.FromSlice([]int32{math.MinInt32 + 1, -5, 10})))
+	assert.Equal(t, []int32{math.MinInt32 + 1, math.MinInt32, math.MinInt32 + 10}, res2)
+
+	res3 := ro.ToSlice(ro.AccumulateSaturatingSlice([]int64{math.MaxInt64, 1, -1}))
+	assert.Equal(t, []int64{math.MaxInt64, math.MaxInt64, math.MaxInt64 - 1}, res3)
+
+	res4 := ro.ToSlice(ro.AccumulateSaturatingSlice([]int8{127, 1, -128, -128}))
+	assert.Equal(t, []int8{127, 127, -1, -128}, res4)
+
+	res5 := ro.ToSlice(ro.AccumulateSaturating(empty[int]()))
+	assert.Equal(t, []int{}, res5)
+
+	res6 := []int{}
+	for v := range ro.AccumulateSaturatingSlice([]int{1, 2, 3}) {
+		res6 = append(res6, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res6)
+}
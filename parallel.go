@@ -0,0 +1,328 @@
+package ro
+
+import (
+	"iter"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+type parallelJob[U any] struct {
+	index int
+	value U
+}
+
+type parallelResult[V any] struct {
+	index int
+	value V
+	keep  bool
+}
+
+// parallelPool pulls elements from next and dispatches them to a pool of workers applying f.
+// f reports whether its result should be yielded.
+//
+// The number of elements in flight is bounded by window, so neither the job nor the result
+// channels ever block a sender.
+type parallelPool[U, V any] struct {
+	next    func() (U, bool)
+	jobs    chan parallelJob[U]
+	results chan parallelResult[V]
+	done    chan struct{}
+	wg      sync.WaitGroup
+	window  int
+
+	sent      int
+	received  int
+	exhausted bool
+}
+
+func newParallelPool[U, V any](next func() (U, bool), f func(U) (V, bool), workers int) *parallelPool[U, V] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	window := 2 * workers
+	p := &parallelPool[U, V]{
+		next:    next,
+		jobs:    make(chan parallelJob[U], window),
+		results: make(chan parallelResult[V], window),
+		done:    make(chan struct{}),
+		window:  window,
+	}
+	for i := 0; i < workers; i++ {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			for {
+				select {
+				case <-p.done:
+					return
+				case job, ok := <-p.jobs:
+					if !ok {
+						return
+					}
+					v, keep := f(job.value)
+					p.results <- parallelResult[V]{index: job.index, value: v, keep: keep}
+				}
+			}
+		}()
+	}
+	return p
+}
+
+// fill pulls elements from the source until the window is full or the source is exhausted.
+// inFlight is the number of elements sent but not yet released by the consumer.
+func (p *parallelPool[U, V]) fill(inFlight int) {
+	for !p.exhausted && inFlight < p.window {
+		v, ok := p.next()
+		if !ok {
+			p.exhausted = true
+			close(p.jobs)
+			return
+		}
+		p.jobs <- parallelJob[U]{index: p.sent, value: v}
+		p.sent++
+		inFlight++
+	}
+}
+
+// receive blocks until any worker produces a result
+func (p *parallelPool[U, V]) receive() parallelResult[V] {
+	res := <-p.results
+	p.received++
+	return res
+}
+
+// close cancels the workers and waits for them to exit
+func (p *parallelPool[U, V]) close() {
+	close(p.done)
+	p.wg.Wait()
+}
+
+// ParallelApply returns an iterator that yields the result of applying f to each element yielded by the sequence,
+// running f on up to workers goroutines. Results are yielded in the same order as the input elements.
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+//
+// At most 2*workers elements are in flight at any time, which bounds the size of the reorder buffer.
+// When the consumer stops early, the workers are cancelled and waited on before the iterator returns.
+func ParallelApply[U, V any](seq iter.Seq[U], f func(U) V, workers int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		next, stop := iter.Pull(seq)
+		defer stop()
+		p := newParallelPool(next, func(u U) (V, bool) { return f(u), true }, workers)
+		defer p.close()
+
+		// reorder buffer : the element with index i is stored at i % window
+		buffer := make([]V, p.window)
+		ready := make([]bool, p.window)
+		yielded := 0
+		for {
+			p.fill(p.sent - yielded)
+			if yielded == p.sent {
+				return
+			}
+			slot := yielded % p.window
+			for !ready[slot] {
+				res := p.receive()
+				buffer[res.index%p.window] = res.value
+				ready[res.index%p.window] = true
+			}
+			val := buffer[slot]
+			var zero V
+			buffer[slot] = zero
+			ready[slot] = false
+			yielded++
+			if !yield(val) {
+				return
+			}
+		}
+	}
+}
+
+func parallelUnordered[U, V any](seq iter.Seq[U], f func(U) (V, bool), workers int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		next, stop := iter.Pull(seq)
+		defer stop()
+		p := newParallelPool(next, f, workers)
+		defer p.close()
+
+		for {
+			p.fill(p.sent - p.received)
+			if p.received == p.sent {
+				return
+			}
+			res := p.receive()
+			if !res.keep {
+				continue
+			}
+			if !yield(res.value) {
+				return
+			}
+		}
+	}
+}
+
+// ParallelApplyUnordered returns an iterator that yields the result of applying f to each element yielded by the sequence,
+// running f on up to workers goroutines. Results are yielded as soon as any worker finishes, in no particular order.
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+//
+// When the consumer stops early, the workers are cancelled and waited on before the iterator returns.
+func ParallelApplyUnordered[U, V any](seq iter.Seq[U], f func(U) V, workers int) iter.Seq[V] {
+	return parallelUnordered(seq, func(u U) (V, bool) { return f(u), true }, workers)
+}
+
+// ParallelFilter returns an iterator that yields elements matching the predicate,
+// evaluating the predicate on up to workers goroutines. Elements are yielded as soon as any worker finishes, in no particular order.
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+//
+// When the consumer stops early, the workers are cancelled and waited on before the iterator returns.
+func ParallelFilter[T any](seq iter.Seq[T], predicate func(T) bool, workers int) iter.Seq[T] {
+	return parallelUnordered(seq, func(v T) (T, bool) { return v, predicate(v) }, workers)
+}
+
+// Merge returns an iterator that yields the elements yielded by seqs in the order they arrive.
+// Each sequence is consumed concurrently by its own goroutine, so a slow or infinite sequence
+// does not prevent the others from making progress.
+//
+// When the consumer stops early, the producer goroutines are cancelled and the iterator returns immediately.
+// A producer only observes the cancellation when its sequence yields its next element, so a producer
+// blocked inside its sequence, such as FromChan on an idle channel, exits once that sequence yields or ends.
+func Merge[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		out := make(chan T)
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		for _, seq := range seqs {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for v := range seq {
+					select {
+					case <-done:
+						return
+					case out <- v:
+					}
+				}
+			}()
+		}
+		go func() {
+			wg.Wait()
+			close(out)
+		}()
+		defer close(done)
+
+		for v := range out {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MergeFair returns an iterator that yields the elements yielded by seqs, consuming each sequence
+// concurrently like Merge. Producers with an element ready are served in round-robin order,
+// so a fast producer cannot monopolize the consumer.
+//
+// When the consumer stops early, the producer goroutines are cancelled and the iterator returns immediately, like Merge.
+func MergeFair[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		n := len(seqs)
+		chans := make([]chan T, n)
+		// wake holds a pending notification that some producer made progress
+		wake := make(chan struct{}, 1)
+		notify := func() {
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		}
+		done := make(chan struct{})
+		for i, seq := range seqs {
+			ch := make(chan T, 1)
+			chans[i] = ch
+			go func() {
+				defer notify()
+				defer close(ch)
+				for v := range seq {
+					select {
+					case <-done:
+						return
+					case ch <- v:
+						notify()
+					}
+				}
+			}()
+		}
+		defer close(done)
+
+		closed := make([]bool, n)
+		open := n
+		cur := 0
+		for open > 0 {
+			ready := false
+			for j := 0; j < n && !ready; j++ {
+				i := (cur + j) % n
+				if closed[i] {
+					continue
+				}
+				select {
+				case v, ok := <-chans[i]:
+					if !ok {
+						closed[i] = true
+						open--
+						continue
+					}
+					ready = true
+					cur = (i + 1) % n
+					if !yield(v) {
+						return
+					}
+				default:
+				}
+			}
+			if !ready && open > 0 {
+				<-wake
+			}
+		}
+	}
+}
+
+// ParallelForEach calls f on each element yielded by seqs, consuming up to workers sequences concurrently.
+// If workers <= 0, runtime.GOMAXPROCS(0) workers are used.
+// f is called concurrently from different goroutines.
+//
+// If f returns false, every sequence stops at its next element and ParallelForEach returns false once
+// the workers have exited. Otherwise, it returns true once every sequence is exhausted.
+func ParallelForEach[T any](seqs []iter.Seq[T], workers int, f func(T) bool) bool {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	var stopped atomic.Bool
+	jobs := make(chan iter.Seq[T], len(seqs))
+	for _, seq := range seqs {
+		jobs <- seq
+	}
+	close(jobs)
+	var wg sync.WaitGroup
+	for i := 0; i < min(workers, len(seqs)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for seq := range jobs {
+				if stopped.Load() {
+					return
+				}
+				for v := range seq {
+					if stopped.Load() {
+						return
+					}
+					if !f(v) {
+						stopped.Store(true)
+						return
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return !stopped.Load()
+}
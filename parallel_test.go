@@ -0,0 +1,262 @@
+package ro_test
+
+import (
+	"iter"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelApply(t *testing.T) {
+	res := []int{}
+	for v := range ro.ParallelApply(ro.Range(0, 100, 1), func(i int) int {
+		// later elements finish first to exercise the reorder buffer
+		time.Sleep(time.Duration(100-i) * time.Microsecond)
+		return i * 2
+	}, 4) {
+		res = append(res, v)
+	}
+	assert.Equal(t, ro.ToSlice(ro.Range(0, 200, 2)), res)
+
+	res2 := []int{}
+	for v := range ro.ParallelApply(ro.FromSlice([]int{}), func(i int) int { return i * 2 }, 4) {
+		res2 = append(res2, v)
+	}
+	assert.Equal(t, []int{}, res2)
+
+	var calls atomic.Int64
+	res3 := []int{}
+	for v := range ro.ParallelApply(ro.Count(0, 1), func(i int) int {
+		calls.Add(1)
+		return i * 2
+	}, 2) {
+		res3 = append(res3, v)
+		if len(res3) == 3 {
+			break
+		}
+	}
+	assert.Equal(t, []int{0, 2, 4}, res3)
+	// workers are drained once the consumer stops, so no more calls can happen
+	n := calls.Load()
+	time.Sleep(time.Millisecond)
+	assert.Equal(t, n, calls.Load())
+	assert.LessOrEqual(t, n, int64(3+4))
+
+	var inFlight, maxInFlight atomic.Int64
+	res4 := ro.ToSlice(ro.ParallelApply(ro.Range(0, 50, 1), func(i int) int {
+		cur := inFlight.Add(1)
+		for {
+			old := maxInFlight.Load()
+			if cur <= old || maxInFlight.CompareAndSwap(old, cur) {
+				break
+			}
+		}
+		time.Sleep(100 * time.Microsecond)
+		inFlight.Add(-1)
+		return i
+	}, 3))
+	assert.Equal(t, ro.ToSlice(ro.Range(0, 50, 1)), res4)
+	assert.LessOrEqual(t, maxInFlight.Load(), int64(3))
+
+	res5 := ro.ToSlice(ro.ParallelApply(ro.Range(0, 10, 1), func(i int) int { return i + 1 }, 0))
+	assert.Equal(t, ro.ToSlice(ro.Range(1, 11, 1)), res5)
+}
+
+func TestParallelApplyUnordered(t *testing.T) {
+	res := ro.ToSlice(ro.ParallelApplyUnordered(ro.Range(0, 100, 1), func(i int) int {
+		time.Sleep(time.Duration(100-i) * time.Microsecond)
+		return i * 2
+	}, 4))
+	assert.ElementsMatch(t, ro.ToSlice(ro.Range(0, 200, 2)), res)
+
+	res2 := ro.ToSlice(ro.ParallelApplyUnordered(ro.FromSlice([]int{}), func(i int) int { return i * 2 }, 4))
+	assert.Equal(t, []int{}, res2)
+
+	// a slow element must not hold back the others
+	res3 := []int{}
+	for v := range ro.ParallelApplyUnordered(ro.FromSlice([]int{0, 1, 2, 3}), func(i int) int {
+		if i == 0 {
+			time.Sleep(50 * time.Millisecond)
+		}
+		return i
+	}, 4) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Len(t, res3, 1)
+	assert.NotEqual(t, 0, res3[0])
+
+	var calls atomic.Int64
+	res4 := []int{}
+	for v := range ro.ParallelApplyUnordered(ro.Count(0, 1), func(i int) int {
+		calls.Add(1)
+		return i
+	}, 2) {
+		res4 = append(res4, v)
+		if len(res4) == 3 {
+			break
+		}
+	}
+	assert.Len(t, res4, 3)
+	n := calls.Load()
+	time.Sleep(time.Millisecond)
+	assert.Equal(t, n, calls.Load())
+}
+
+func TestParallelFilter(t *testing.T) {
+	res := ro.ToSlice(ro.ParallelFilter(ro.Range(1, 10, 1), func(i int) bool { return i%3 == 0 }, 3))
+	assert.ElementsMatch(t, []int{3, 6, 9}, res)
+
+	res2 := ro.ToSlice(ro.ParallelFilter(ro.FromSlice([]int{}), func(i int) bool { return i%3 == 0 }, 3))
+	assert.Equal(t, []int{}, res2)
+
+	res3 := ro.ToSlice(ro.ParallelFilter(ro.Range(1, 10, 1), func(i int) bool { return i > 100 }, 3))
+	assert.Equal(t, []int{}, res3)
+
+	res4 := []int{}
+	for v := range ro.ParallelFilter(ro.Count(1, 1), func(i int) bool { return i%3 == 0 }, 3) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Len(t, res4, 1)
+	assert.Equal(t, 0, res4[0]%3)
+}
+
+// stoppingSeq returns an infinite iterator yielding elem, along with a channel closed once the iterator returns
+func stoppingSeq(elem int) (iter.Seq[int], <-chan struct{}) {
+	stopped := make(chan struct{})
+	return func(yield func(int) bool) {
+		defer close(stopped)
+		for yield(elem) {
+		}
+	}, stopped
+}
+
+// assertClosed asserts that ch is closed within a second
+func assertClosed(t *testing.T, ch <-chan struct{}, msg string) {
+	t.Helper()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		assert.Fail(t, msg)
+	}
+}
+
+// breakAfterFirst ranges over seq in a new goroutine, stopping after the first element,
+// and returns a channel closed once the range loop returns
+func breakAfterFirst(seq iter.Seq[int]) <-chan struct{} {
+	returned := make(chan struct{})
+	go func() {
+		defer close(returned)
+		for range seq {
+			break
+		}
+	}()
+	return returned
+}
+
+// gatedSeq returns an infinite sequence of elem which signals on the returned channel every time
+// an element has been handed off to the consumer. Up to n signals are buffered.
+func gatedSeq(elem, n int) (iter.Seq[int], <-chan struct{}) {
+	ready := make(chan struct{}, n)
+	return func(yield func(int) bool) {
+		for yield(elem) {
+			ready <- struct{}{}
+		}
+	}, ready
+}
+
+func TestMerge(t *testing.T) {
+	res := ro.ToSlice(ro.Merge(ro.FromSlice([]int{1, 2, 3}), ro.FromSlice([]int{4, 5}), ro.FromSlice([]int{6})))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, res)
+
+	// elements of each input keep their relative order
+	res1 := ro.ToSlice(ro.Filter(ro.Merge(ro.Range(0, 100, 1), ro.Range(100, 200, 1)), func(i int) bool { return i < 100 }))
+	assert.Equal(t, ro.ToSlice(ro.Range(0, 100, 1)), res1)
+
+	res2 := ro.ToSlice(ro.Merge[int]())
+	assert.Equal(t, []int{}, res2)
+
+	res3 := ro.ToSlice(ro.Merge(ro.FromSlice([]int{}), ro.FromSlice([]int{})))
+	assert.Equal(t, []int{}, res3)
+
+	// an infinite input does not starve the others
+	found := false
+	for v := range ro.Merge(ro.Count(0, 1), ro.FromSlice([]int{-1})) {
+		if v == -1 {
+			found = true
+			break
+		}
+	}
+	assert.True(t, found)
+
+	seq1, stopped1 := stoppingSeq(1)
+	seq2, stopped2 := stoppingSeq(2)
+	res4 := []int{}
+	for v := range ro.Merge(seq1, seq2) {
+		res4 = append(res4, v)
+		if len(res4) == 5 {
+			break
+		}
+	}
+	assert.Len(t, res4, 5)
+	assertClosed(t, stopped1, "producer was not cancelled")
+	assertClosed(t, stopped2, "producer was not cancelled")
+
+	// stopping early does not wait for a producer blocked inside its sequence
+	idle := make(chan int)
+	defer close(idle)
+	assertClosed(t, breakAfterFirst(ro.Merge(ro.FromChan(idle), ro.FromSlice([]int{1}))), "Merge waited on an idle producer")
+}
+
+func TestMergeFair(t *testing.T) {
+	res := ro.ToSlice(ro.MergeFair(ro.FromSlice([]int{1, 2, 3}), ro.FromSlice([]int{4, 5}), ro.FromSlice([]int{6})))
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5, 6}, res)
+
+	res1 := ro.ToSlice(ro.Filter(ro.MergeFair(ro.Range(0, 100, 1), ro.Range(100, 200, 1)), func(i int) bool { return i < 100 }))
+	assert.Equal(t, ro.ToSlice(ro.Range(0, 100, 1)), res1)
+
+	res2 := ro.ToSlice(ro.MergeFair[int]())
+	assert.Equal(t, []int{}, res2)
+
+	res3 := ro.ToSlice(ro.MergeFair(ro.FromSlice([]int{}), ro.FromSlice([]int{})))
+	assert.Equal(t, []int{}, res3)
+
+	// both producers have an element ready before each receive, so they must be served alternately
+	seq1, ready1 := gatedSeq(1, 64)
+	seq2, ready2 := gatedSeq(2, 64)
+	ready := map[int]<-chan struct{}{1: ready1, 2: ready2}
+	handed, taken := map[int]int{}, map[int]int{}
+	res4 := []int{}
+	for v := range ro.MergeFair(seq1, seq2) {
+		res4 = append(res4, v)
+		taken[v]++
+		if len(res4) == 20 {
+			break
+		}
+		for i, ch := range ready {
+			for handed[i] <= taken[i] {
+				<-ch
+				handed[i]++
+			}
+		}
+	}
+	assert.Len(t, res4, 20)
+	for i := 1; i < len(res4); i++ {
+		assert.NotEqual(t, res4[i-1], res4[i])
+	}
+
+	seq3, stopped3 := stoppingSeq(1)
+	seq4, stopped4 := stoppingSeq(2)
+	res5 := ro.ToSlice(ro.Limit(ro.MergeFair(seq3, seq4), 100))
+	assert.Len(t, res5, 100)
+	assertClosed(t, stopped3, "producer was not cancelled")
+	assertClosed(t, stopped4, "producer was not cancelled")
+
+	idle := make(chan int)
+	defer close(idle)
+	assertClosed(t, breakAfterFirst(ro.MergeFair(ro.FromChan(idle), ro.FromSlice([]int{1}))), "MergeFair waited on an idle producer")
+}
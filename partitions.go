@@ -0,0 +1,398 @@
+package ro
+
+import (
+	"iter"
+	"math/big"
+	"slices"
+)
+
+// emitter returns a function yielding either out itself or a copy of it
+func emitter[T any](yield func([]T) bool, inPlace bool) func([]T) bool {
+	if inPlace {
+		return yield
+	}
+	return func(out []T) bool {
+		return yield(slices.Clone(out))
+	}
+}
+
+// Partitions returns an iterator that yields all partitions of n, the ways to write n as a sum of positive integers
+// regardless of their order. Each partition is yielded with its parts in decreasing order, and partitions are
+// yielded in reverse lexicographic order, e.g. 4 yields [4], [3, 1], [2, 2], [2, 1, 1], [1, 1, 1, 1].
+// If n == 0, the empty partition is yielded. If n < 0, the empty iterator is returned.
+//
+// Each yielded partition is a newly allocated slice, see PartitionsInPlace to avoid the allocation.
+func Partitions(n int) iter.Seq[[]int] {
+	return partitions(n, false)
+}
+
+// PartitionsInPlace returns an iterator that yields all partitions of n like Partitions.
+//
+// The same backing array is reused for each yielded partition, so callers must copy it to retain it past the current iteration.
+func PartitionsInPlace(n int) iter.Seq[[]int] {
+	return partitions(n, true)
+}
+
+func partitions(n int, inPlace bool) iter.Seq[[]int] {
+	if n < 0 {
+		return empty[[]int]()
+	}
+	return func(yield func([]int) bool) {
+		emit := emitter(yield, inPlace)
+		parts := make([]int, 0, n)
+		if n > 0 {
+			parts = append(parts, n)
+		}
+		for {
+			if !emit(parts) {
+				return
+			}
+			// gather the trailing ones, then split the last part larger than one
+			rem := 0
+			for len(parts) > 0 && parts[len(parts)-1] == 1 {
+				parts = parts[:len(parts)-1]
+				rem++
+			}
+			if len(parts) == 0 {
+				return
+			}
+			last := parts[len(parts)-1] - 1
+			parts[len(parts)-1] = last
+			rem++
+			for rem > last {
+				parts = append(parts, last)
+				rem -= last
+			}
+			if rem > 0 {
+				parts = append(parts, rem)
+			}
+		}
+	}
+}
+
+// PartitionsK returns an iterator that yields all partitions of n into exactly k parts, in the same order as Partitions,
+// e.g. (6, 3) yields [4, 1, 1], [3, 2, 1], [2, 2, 2].
+// If k <= 0 or k > n, the empty iterator is returned.
+//
+// Each yielded partition is a newly allocated slice, see PartitionsKInPlace to avoid the allocation.
+func PartitionsK(n, k int) iter.Seq[[]int] {
+	return partitionsK(n, k, false)
+}
+
+// PartitionsKInPlace returns an iterator that yields all partitions of n into exactly k parts like PartitionsK.
+//
+// The same slice is reused for each yielded partition, so callers must copy it to retain it past the current iteration.
+func PartitionsKInPlace(n, k int) iter.Seq[[]int] {
+	return partitionsK(n, k, true)
+}
+
+func partitionsK(n, k int, inPlace bool) iter.Seq[[]int] {
+	if k <= 0 || k > n {
+		return empty[[]int]()
+	}
+	return func(yield func([]int) bool) {
+		emit := emitter(yield, inPlace)
+		parts := make([]int, k)
+		parts[0] = n - k + 1
+		for i := 1; i < k; i++ {
+			parts[i] = 1
+		}
+		for {
+			if !emit(parts) {
+				return
+			}
+			// find the rightmost part that can be decremented while the following parts
+			// are refilled with values no larger than it
+			i := k - 2
+			suffix := parts[k-1]
+			for ; i >= 0; i-- {
+				v := parts[i] - 1
+				rest := suffix + 1
+				m := k - 1 - i
+				if v >= 1 && rest <= m*v {
+					parts[i] = v
+					for j := i + 1; j < k; j++ {
+						parts[j] = min(v, rest-(k-1-j))
+						rest -= parts[j]
+					}
+					break
+				}
+				suffix += parts[i]
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Compositions returns an iterator that yields all compositions of n into k parts, the ways to write n
+// as an ordered sum of k positive integers, in lexicographic order,
+// e.g. (4, 2) yields [1, 3], [2, 2], [3, 1].
+// If k <= 0 or k > n, the empty iterator is returned.
+//
+// Each yielded composition is a newly allocated slice, see CompositionsInPlace to avoid the allocation.
+func Compositions(n, k int) iter.Seq[[]int] {
+	if k > n {
+		return empty[[]int]()
+	}
+	return weakCompositions(n-k, k, 1, false)
+}
+
+// CompositionsInPlace returns an iterator that yields all compositions of n into k parts like Compositions.
+//
+// The same slice is reused for each yielded composition, so callers must copy it to retain it past the current iteration.
+func CompositionsInPlace(n, k int) iter.Seq[[]int] {
+	if k > n {
+		return empty[[]int]()
+	}
+	return weakCompositions(n-k, k, 1, true)
+}
+
+// WeakCompositions returns an iterator that yields all weak compositions of n into k parts, the ways to write n
+// as an ordered sum of k non-negative integers (stars and bars), in lexicographic order,
+// e.g. (2, 2) yields [0, 2], [1, 1], [2, 0].
+// If k <= 0 or n < 0, the empty iterator is returned.
+//
+// Each yielded composition is a newly allocated slice, see WeakCompositionsInPlace to avoid the allocation.
+func WeakCompositions(n, k int) iter.Seq[[]int] {
+	return weakCompositions(n, k, 0, false)
+}
+
+// WeakCompositionsInPlace returns an iterator that yields all weak compositions of n into k parts like WeakCompositions.
+//
+// The same slice is reused for each yielded composition, so callers must copy it to retain it past the current iteration.
+func WeakCompositionsInPlace(n, k int) iter.Seq[[]int] {
+	return weakCompositions(n, k, 0, true)
+}
+
+// weakCompositions yields the weak compositions of n into k parts, with offset added to each part
+func weakCompositions(n, k, offset int, inPlace bool) iter.Seq[[]int] {
+	if k <= 0 || n < 0 {
+		return empty[[]int]()
+	}
+	return func(yield func([]int) bool) {
+		emit := emitter(yield, inPlace)
+		parts := make([]int, k)
+		parts[k-1] = n
+		out := make([]int, k)
+		for {
+			for i, p := range parts {
+				out[i] = p + offset
+			}
+			if !emit(out) {
+				return
+			}
+			// move one unit from the last non-zero part (other than the first) to its left neighbour,
+			// and the rest of it to the last part
+			j := k - 1
+			for j > 0 && parts[j] == 0 {
+				j--
+			}
+			if j == 0 {
+				return
+			}
+			t := parts[j]
+			parts[j-1]++
+			parts[j] = 0
+			parts[k-1] = t - 1
+		}
+	}
+}
+
+// CountPartitions returns the number of partitions of n yielded by Partitions
+func CountPartitions(n int) *big.Int {
+	if n < 0 {
+		return big.NewInt(0)
+	}
+	// Euler's pentagonal number theorem : p(m) = sum_{j>=1} (-1)^(j+1) * (p(m - j(3j-1)/2) + p(m - j(3j+1)/2))
+	p := make([]*big.Int, n+1)
+	p[0] = big.NewInt(1)
+	for m := 1; m <= n; m++ {
+		p[m] = new(big.Int)
+		for j := 1; ; j++ {
+			g1 := j * (3*j - 1) / 2
+			if g1 > m {
+				break
+			}
+			term := new(big.Int).Set(p[m-g1])
+			if g2 := j * (3*j + 1) / 2; g2 <= m {
+				term.Add(term, p[m-g2])
+			}
+			if j%2 == 1 {
+				p[m].Add(p[m], term)
+			} else {
+				p[m].Sub(p[m], term)
+			}
+		}
+	}
+	return p[n]
+}
+
+// CountPartitionsK returns the number of partitions of n into exactly k parts yielded by PartitionsK
+func CountPartitionsK(n, k int) *big.Int {
+	if k <= 0 || k > n {
+		return big.NewInt(0)
+	}
+	// p(m, j) = p(m-1, j-1) + p(m-j, j), tabulated for j <= k
+	p := make([][]*big.Int, n+1)
+	for m := range p {
+		p[m] = make([]*big.Int, k+1)
+		for j := range p[m] {
+			switch {
+			case j == 0 && m == 0:
+				p[m][j] = big.NewInt(1)
+			case j == 0 || j > m:
+				p[m][j] = big.NewInt(0)
+			default:
+				p[m][j] = new(big.Int).Add(p[m-1][j-1], p[m-j][j])
+			}
+		}
+	}
+	return p[n][k]
+}
+
+// CountCompositions returns the number of compositions of n into k parts yielded by Compositions
+func CountCompositions(n, k int) *big.Int {
+	if k <= 0 {
+		return big.NewInt(0)
+	}
+	return Binomial(n-1, k-1)
+}
+
+// CountWeakCompositions returns the number of weak compositions of n into k parts yielded by WeakCompositions
+func CountWeakCompositions(n, k int) *big.Int {
+	if k <= 0 || n < 0 {
+		return big.NewInt(0)
+	}
+	return Binomial(n+k-1, k-1)
+}
+
+// SetPartitions returns an iterator that yields all partitions of the slice into non-empty blocks,
+// e.g. [A, B, C] yields [[A, B, C]], [[A, B], [C]], [[A, C], [B]], [[A], [B, C]], [[A], [B], [C]].
+// Elements keep their relative order within each block, and blocks are ordered by their first element.
+// If the slice is empty, the empty partition is yielded.
+//
+// Partitions are generated from restricted growth strings in lexicographic order,
+// and each yielded partition is newly allocated.
+func SetPartitions[T any](arr []T) iter.Seq[[][]T] {
+	return setPartitions(arr, -1)
+}
+
+// SetPartitionsK returns an iterator that yields all partitions of the slice into exactly k non-empty blocks,
+// in the same order as SetPartitions.
+// If the slice is empty and k == 0, the empty partition is yielded, matching Stirling2(0, 0) == 1.
+// Otherwise, if k <= 0 or k > len(arr), the empty iterator is returned.
+func SetPartitionsK[T any](arr []T, k int) iter.Seq[[][]T] {
+	if k == 0 && len(arr) == 0 {
+		return setPartitions(arr, -1)
+	}
+	if k <= 0 || k > len(arr) {
+		return empty[[][]T]()
+	}
+	return setPartitions(arr, k)
+}
+
+// setPartitions yields the partitions of arr into exactly k blocks, or into any number of blocks if k < 0
+func setPartitions[T any](arr []T, k int) iter.Seq[[][]T] {
+	return func(yield func([][]T) bool) {
+		n := len(arr)
+		// rgs[i] is the block of arr[i], and rgs[i] <= 1 + max(rgs[:i])
+		rgs := make([]int, n)
+		if k > 0 {
+			for j := 1; j < k; j++ {
+				rgs[n-k+j] = j
+			}
+		}
+		for {
+			blocks := [][]T{}
+			for i, b := range rgs {
+				if b == len(blocks) {
+					blocks = append(blocks, []T{})
+				}
+				blocks[b] = append(blocks[b], arr[i])
+			}
+			if !yield(blocks) {
+				return
+			}
+			if !nextRestrictedGrowth(rgs, k) {
+				return
+			}
+		}
+	}
+}
+
+// nextRestrictedGrowth advances rgs to the next restricted growth string in lexicographic order
+// with exactly k blocks, or with any number of blocks if k < 0. It returns false if there is none.
+func nextRestrictedGrowth(rgs []int, k int) bool {
+	n := len(rgs)
+	// prefixMax[i] is the largest block in rgs[:i+1]
+	prefixMax := make([]int, n)
+	for i := 1; i < n; i++ {
+		prefixMax[i] = max(prefixMax[i-1], rgs[i])
+	}
+	for i := n - 1; i >= 1; i-- {
+		for v := rgs[i] + 1; v <= prefixMax[i-1]+1; v++ {
+			top := max(prefixMax[i-1], v)
+			// the remaining positions must open the blocks still missing
+			missing := 0
+			if k > 0 {
+				missing = k - 1 - top
+				if missing < 0 {
+					break
+				}
+				if missing > n-1-i {
+					continue
+				}
+			}
+			rgs[i] = v
+			for j := i + 1; j < n; j++ {
+				rgs[j] = 0
+			}
+			for j := 1; j <= missing; j++ {
+				rgs[n-missing+j-1] = top + j
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Stirling2 returns the Stirling number of the second kind, the number of partitions of n elements
+// into exactly k non-empty blocks yielded by SetPartitionsK.
+func Stirling2(n, k int) *big.Int {
+	if n < 0 || k < 0 || k > n {
+		return big.NewInt(0)
+	}
+	return stirling2Row(n)[k]
+}
+
+// Bell returns the Bell number, the number of partitions of n elements into non-empty blocks yielded by SetPartitions.
+func Bell(n int) *big.Int {
+	if n < 0 {
+		return big.NewInt(0)
+	}
+	res := new(big.Int)
+	for _, s := range stirling2Row(n) {
+		res.Add(res, s)
+	}
+	return res
+}
+
+// stirling2Row returns S(n, k) for 0 <= k <= n, using S(n, k) = k * S(n-1, k) + S(n-1, k-1)
+func stirling2Row(n int) []*big.Int {
+	row := []*big.Int{big.NewInt(1)}
+	for m := 1; m <= n; m++ {
+		next := make([]*big.Int, m+1)
+		next[0] = big.NewInt(0)
+		for k := 1; k <= m; k++ {
+			s := new(big.Int)
+			if k < m {
+				s.Mul(big.NewInt(int64(k)), row[k])
+			}
+			next[k] = s.Add(s, row[k-1])
+		}
+		row = next
+	}
+	return row
+}
@@ -0,0 +1,244 @@
+package ro_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPartitions(t *testing.T) {
+	res := ro.ToSlice(ro.Partitions(4))
+	assert.Equal(t, [][]int{{4}, {3, 1}, {2, 2}, {2, 1, 1}, {1, 1, 1, 1}}, res)
+
+	res2 := ro.ToSlice(ro.Partitions(0))
+	assert.Equal(t, [][]int{{}}, res2)
+
+	res3 := ro.ToSlice(ro.Partitions(-1))
+	assert.Equal(t, [][]int{}, res3)
+
+	res4 := [][]int{}
+	for v := range ro.Partitions(4) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, [][]int{{4}}, res4)
+
+	for n := 1; n <= 20; n++ {
+		parts := ro.ToSlice(ro.Partitions(n))
+		assert.Equal(t, ro.CountPartitions(n), big.NewInt(int64(len(parts))), "n=%d", n)
+		for _, p := range parts {
+			assert.Equal(t, n, lo.Sum(p))
+			assert.True(t, slices.IsSortedFunc(p, func(a, b int) int { return b - a }))
+		}
+	}
+}
+
+func TestPartitionsInPlace(t *testing.T) {
+	res := [][]int{}
+	for v := range ro.PartitionsInPlace(5) {
+		res = append(res, slices.Clone(v))
+	}
+	assert.Equal(t, ro.ToSlice(ro.Partitions(5)), res)
+
+	res2 := ro.ToSlice(ro.PartitionsInPlace(0))
+	assert.Equal(t, [][]int{{}}, res2)
+}
+
+func TestPartitionsK(t *testing.T) {
+	res := ro.ToSlice(ro.PartitionsK(6, 3))
+	assert.Equal(t, [][]int{{4, 1, 1}, {3, 2, 1}, {2, 2, 2}}, res)
+
+	res2 := ro.ToSlice(ro.PartitionsK(3, 4))
+	assert.Equal(t, [][]int{}, res2)
+
+	res3 := ro.ToSlice(ro.PartitionsK(3, 0))
+	assert.Equal(t, [][]int{}, res3)
+
+	res4 := ro.ToSlice(ro.PartitionsK(5, 1))
+	assert.Equal(t, [][]int{{5}}, res4)
+
+	for n := 1; n <= 15; n++ {
+		all := ro.ToSlice(ro.Partitions(n))
+		for k := 1; k <= n; k++ {
+			expected := lo.Filter(all, func(p []int, _ int) bool { return len(p) == k })
+			assert.Equal(t, expected, ro.ToSlice(ro.PartitionsK(n, k)), "n=%d k=%d", n, k)
+			assert.Equal(t, big.NewInt(int64(len(expected))), ro.CountPartitionsK(n, k), "n=%d k=%d", n, k)
+		}
+	}
+
+	res5 := [][]int{}
+	for v := range ro.PartitionsKInPlace(8, 3) {
+		res5 = append(res5, slices.Clone(v))
+	}
+	assert.Equal(t, ro.ToSlice(ro.PartitionsK(8, 3)), res5)
+}
+
+func TestCompositions(t *testing.T) {
+	res := ro.ToSlice(ro.Compositions(4, 2))
+	assert.Equal(t, [][]int{{1, 3}, {2, 2}, {3, 1}}, res)
+
+	res2 := ro.ToSlice(ro.Compositions(2, 3))
+	assert.Equal(t, [][]int{}, res2)
+
+	res3 := ro.ToSlice(ro.Compositions(2, 0))
+	assert.Equal(t, [][]int{}, res3)
+
+	res4 := ro.ToSlice(ro.Compositions(3, 3))
+	assert.Equal(t, [][]int{{1, 1, 1}}, res4)
+
+	for n := 1; n <= 10; n++ {
+		for k := 1; k <= n; k++ {
+			comps := ro.ToSlice(ro.Compositions(n, k))
+			assert.Equal(t, ro.CountCompositions(n, k), big.NewInt(int64(len(comps))))
+			assert.True(t, slices.IsSortedFunc(comps, slices.Compare[[]int]))
+			for _, c := range comps {
+				assert.Equal(t, n, lo.Sum(c))
+				assert.Len(t, c, k)
+			}
+		}
+	}
+
+	res5 := [][]int{}
+	for v := range ro.CompositionsInPlace(5, 3) {
+		res5 = append(res5, slices.Clone(v))
+	}
+	assert.Equal(t, ro.ToSlice(ro.Compositions(5, 3)), res5)
+}
+
+func TestWeakCompositions(t *testing.T) {
+	res := ro.ToSlice(ro.WeakCompositions(2, 2))
+	assert.Equal(t, [][]int{{0, 2}, {1, 1}, {2, 0}}, res)
+
+	res2 := ro.ToSlice(ro.WeakCompositions(0, 3))
+	assert.Equal(t, [][]int{{0, 0, 0}}, res2)
+
+	res3 := ro.ToSlice(ro.WeakCompositions(2, 0))
+	assert.Equal(t, [][]int{}, res3)
+
+	res4 := ro.ToSlice(ro.WeakCompositions(-1, 2))
+	assert.Equal(t, [][]int{}, res4)
+
+	res5 := [][]int{}
+	for v := range ro.WeakCompositions(3, 2) {
+		res5 = append(res5, v)
+		break
+	}
+	assert.Equal(t, [][]int{{0, 3}}, res5)
+
+	for n := 0; n <= 8; n++ {
+		for k := 1; k <= 4; k++ {
+			comps := ro.ToSlice(ro.WeakCompositions(n, k))
+			assert.Equal(t, ro.CountWeakCompositions(n, k), big.NewInt(int64(len(comps))))
+			assert.True(t, slices.IsSortedFunc(comps, slices.Compare[[]int]))
+			for _, c := range comps {
+				assert.Equal(t, n, lo.Sum(c))
+			}
+		}
+	}
+
+	res6 := [][]int{}
+	for v := range ro.WeakCompositionsInPlace(3, 3) {
+		res6 = append(res6, slices.Clone(v))
+	}
+	assert.Equal(t, ro.ToSlice(ro.WeakCompositions(3, 3)), res6)
+}
+
+func TestCountPartitions(t *testing.T) {
+	assert.Equal(t, big.NewInt(1), ro.CountPartitions(0))
+	assert.Equal(t, big.NewInt(0), ro.CountPartitions(-1))
+	assert.Equal(t, big.NewInt(190569292), ro.CountPartitions(100))
+
+	expected, _ := new(big.Int).SetString("24061467864032622473692149727991", 10)
+	assert.Equal(t, expected, ro.CountPartitions(1000))
+
+	assert.Equal(t, big.NewInt(0), ro.CountPartitionsK(3, 0))
+	assert.Equal(t, big.NewInt(0), ro.CountPartitionsK(3, 4))
+	assert.Equal(t, big.NewInt(0), ro.CountCompositions(3, 0))
+	assert.Equal(t, big.NewInt(0), ro.CountWeakCompositions(-1, 2))
+}
+
+func TestSetPartitions(t *testing.T) {
+	res := ro.ToSlice(ro.SetPartitions([]string{"A", "B", "C"}))
+	assert.Equal(t, [][][]string{
+		{{"A", "B", "C"}},
+		{{"A", "B"}, {"C"}},
+		{{"A", "C"}, {"B"}},
+		{{"A"}, {"B", "C"}},
+		{{"A"}, {"B"}, {"C"}},
+	}, res)
+
+	res2 := ro.ToSlice(ro.SetPartitions([]string{}))
+	assert.Equal(t, [][][]string{{}}, res2)
+
+	res3 := ro.ToSlice(ro.SetPartitions([]string{"A"}))
+	assert.Equal(t, [][][]string{{{"A"}}}, res3)
+
+	res4 := [][][]string{}
+	for v := range ro.SetPartitions([]string{"A", "B", "C"}) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, [][][]string{{{"A", "B", "C"}}}, res4)
+
+	for n := 0; n <= 8; n++ {
+		arr := ro.ToSlice(ro.Range(0, n, 1))
+		parts := ro.ToSlice(ro.SetPartitions(arr))
+		assert.Equal(t, ro.Bell(n), big.NewInt(int64(len(parts))), "n=%d", n)
+		for _, p := range parts {
+			assert.ElementsMatch(t, arr, lo.Flatten(p))
+		}
+	}
+}
+
+func TestSetPartitionsK(t *testing.T) {
+	res := ro.ToSlice(ro.SetPartitionsK([]string{"A", "B", "C"}, 2))
+	assert.Equal(t, [][][]string{
+		{{"A", "B"}, {"C"}},
+		{{"A", "C"}, {"B"}},
+		{{"A"}, {"B", "C"}},
+	}, res)
+
+	res2 := ro.ToSlice(ro.SetPartitionsK([]string{"A", "B", "C"}, 0))
+	assert.Equal(t, [][][]string{}, res2)
+
+	res3 := ro.ToSlice(ro.SetPartitionsK([]string{"A", "B", "C"}, 4))
+	assert.Equal(t, [][][]string{}, res3)
+
+	res4 := ro.ToSlice(ro.SetPartitionsK([]string{"A", "B", "C"}, 3))
+	assert.Equal(t, [][][]string{{{"A"}, {"B"}, {"C"}}}, res4)
+
+	res5 := ro.ToSlice(ro.SetPartitionsK([]string{}, 0))
+	assert.Equal(t, [][][]string{{}}, res5)
+	assert.Equal(t, big.NewInt(1), ro.Stirling2(0, 0))
+
+	res6 := ro.ToSlice(ro.SetPartitionsK([]string{}, 1))
+	assert.Equal(t, [][][]string{}, res6)
+
+	for n := 1; n <= 8; n++ {
+		arr := ro.ToSlice(ro.Range(0, n, 1))
+		all := ro.ToSlice(ro.SetPartitions(arr))
+		for k := 1; k <= n; k++ {
+			expected := lo.Filter(all, func(p [][]int, _ int) bool { return len(p) == k })
+			assert.Equal(t, expected, ro.ToSlice(ro.SetPartitionsK(arr, k)), "n=%d k=%d", n, k)
+			assert.Equal(t, big.NewInt(int64(len(expected))), ro.Stirling2(n, k), "n=%d k=%d", n, k)
+		}
+	}
+}
+
+func TestBell(t *testing.T) {
+	assert.Equal(t, big.NewInt(1), ro.Bell(0))
+	assert.Equal(t, big.NewInt(52), ro.Bell(5))
+	assert.Equal(t, big.NewInt(0), ro.Bell(-1))
+
+	expected, _ := new(big.Int).SetString("185724268771078270438257767181908917499221852770", 10)
+	assert.Equal(t, expected, ro.Bell(50))
+
+	assert.Equal(t, big.NewInt(1), ro.Stirling2(0, 0))
+	assert.Equal(t, big.NewInt(0), ro.Stirling2(3, 0))
+	assert.Equal(t, big.NewInt(25), ro.Stirling2(5, 3))
+	assert.Equal(t, big.NewInt(0), ro.Stirling2(3, 4))
+}
@@ -0,0 +1,171 @@
+package ro
+
+import (
+	"errors"
+	"math/big"
+)
+
+var (
+	// ErrIndexOutOfRange is returned when unranking an index outside of the enumerated space
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrInvalidIndices is returned when ranking positions that do not form a valid combinatorial object
+	ErrInvalidIndices = errors.New("invalid indices")
+)
+
+// Binomial returns the number of k-length combinations of n elements, n! / (k! * (n-k)!)
+// If k < 0 or k > n, 0 is returned.
+func Binomial(n, k int) *big.Int {
+	if n < 0 || k < 0 || k > n {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Binomial(int64(n), int64(k))
+}
+
+// FallingFactorial returns the number of k-length permutations of n elements, n * (n-1) * ... * (n-k+1)
+// If k < 0 or k > n, 0 is returned.
+func FallingFactorial(n, k int) *big.Int {
+	if n < 0 || k < 0 || k > n {
+		return big.NewInt(0)
+	}
+	if k == 0 {
+		return big.NewInt(1)
+	}
+	return new(big.Int).MulRange(int64(n-k+1), int64(n))
+}
+
+// combinationIndicesAt returns the positions of the idx-th k-length combination of n elements in lexicographic order
+func combinationIndicesAt(n, k int, idx *big.Int) ([]int, error) {
+	if idx.Sign() < 0 || idx.Cmp(Binomial(n, k)) >= 0 {
+		return nil, ErrIndexOutOfRange
+	}
+	rem := new(big.Int).Set(idx)
+	indices := make([]int, k)
+	c := 0
+	for i := 0; i < k; i++ {
+		// skip the combinations starting with c at position i
+		for {
+			count := Binomial(n-c-1, k-i-1)
+			if rem.Cmp(count) < 0 {
+				break
+			}
+			rem.Sub(rem, count)
+			c++
+		}
+		indices[i] = c
+		c++
+	}
+	return indices, nil
+}
+
+// permutationIndicesAt returns the positions of the idx-th k-length permutation of n elements in lexicographic order
+func permutationIndicesAt(n, k int, idx *big.Int) ([]int, error) {
+	if idx.Sign() < 0 || idx.Cmp(FallingFactorial(n, k)) >= 0 {
+		return nil, ErrIndexOutOfRange
+	}
+	remaining := make([]int, n)
+	for i := range remaining {
+		remaining[i] = i
+	}
+	rem := new(big.Int).Set(idx)
+	digit := new(big.Int)
+	indices := make([]int, k)
+	for i := 0; i < k; i++ {
+		// the i-th digit of the Lehmer code selects among the unused positions
+		digit.QuoRem(rem, FallingFactorial(n-i-1, k-i-1), rem)
+		d := int(digit.Int64())
+		indices[i] = remaining[d]
+		remaining = append(remaining[:d], remaining[d+1:]...)
+	}
+	return indices, nil
+}
+
+// CombinationAt returns the idx-th k-length combination of the slice, in the order yielded by Combinations,
+// without enumerating the combinations before it.
+// If idx is outside of [0, Binomial(len(arr), k)), ErrIndexOutOfRange is returned.
+// If k > len(seq), k is reduced to len(seq)
+func CombinationAt[T any](arr []T, k int, idx *big.Int) ([]T, error) {
+	k = min(k, len(arr))
+	if k <= 0 {
+		return nil, ErrIndexOutOfRange
+	}
+	indices, err := combinationIndicesAt(len(arr), k, idx)
+	if err != nil {
+		return nil, err
+	}
+	return pick(arr, indices), nil
+}
+
+// PermutationAt returns the idx-th k-length permutation of the slice, in the order yielded by Permutations,
+// without enumerating the permutations before it.
+// If idx is outside of [0, FallingFactorial(len(arr), k)), ErrIndexOutOfRange is returned.
+// If k > len(seq), k is reduced to len(seq)
+func PermutationAt[T any](arr []T, k int, idx *big.Int) ([]T, error) {
+	if k <= 0 {
+		return nil, ErrIndexOutOfRange
+	}
+	k = min(k, len(arr))
+	indices, err := permutationIndicesAt(len(arr), k, idx)
+	if err != nil {
+		return nil, err
+	}
+	return pick(arr, indices), nil
+}
+
+// CombinationRank returns the index of the combination of n elements given by its positions,
+// in the order yielded by Combinations. It is the inverse of CombinationAt.
+// If the positions are not strictly increasing in [0, n), ErrInvalidIndices is returned.
+func CombinationRank(n int, indices []int) (*big.Int, error) {
+	k := len(indices)
+	if k > n {
+		return nil, ErrInvalidIndices
+	}
+	rank := new(big.Int)
+	prev := -1
+	for i, idx := range indices {
+		if idx <= prev || idx >= n {
+			return nil, ErrInvalidIndices
+		}
+		for c := prev + 1; c < idx; c++ {
+			rank.Add(rank, Binomial(n-c-1, k-i-1))
+		}
+		prev = idx
+	}
+	return rank, nil
+}
+
+// PermutationRank returns the index of the permutation of n elements given by its positions,
+// in the order yielded by Permutations. It is the inverse of PermutationAt.
+// If the positions are not distinct or not in [0, n), ErrInvalidIndices is returned.
+func PermutationRank(n int, indices []int) (*big.Int, error) {
+	k := len(indices)
+	if k > n {
+		return nil, ErrInvalidIndices
+	}
+	used := make([]bool, n)
+	rank := new(big.Int)
+	digit := new(big.Int)
+	for i, idx := range indices {
+		if idx < 0 || idx >= n || used[idx] {
+			return nil, ErrInvalidIndices
+		}
+		// the Lehmer code digit is the number of unused positions smaller than idx
+		d := 0
+		for j := 0; j < idx; j++ {
+			if !used[j] {
+				d++
+			}
+		}
+		used[idx] = true
+		digit.SetInt64(int64(d))
+		rank.Add(rank, digit.Mul(digit, FallingFactorial(n-i-1, k-i-1)))
+	}
+	return rank, nil
+}
+
+func pick[T any](arr []T, indices []int) []T {
+	res := make([]T, len(indices))
+	for i, idx := range indices {
+		res[i] = arr[idx]
+	}
+	return res
+}
@@ -0,0 +1,137 @@
+package ro_test
+
+import (
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBinomial(t *testing.T) {
+	assert.Equal(t, big.NewInt(6), ro.Binomial(4, 2))
+	assert.Equal(t, big.NewInt(1), ro.Binomial(4, 0))
+	assert.Equal(t, big.NewInt(1), ro.Binomial(0, 0))
+	assert.Equal(t, big.NewInt(0), ro.Binomial(4, 5))
+	assert.Equal(t, big.NewInt(0), ro.Binomial(4, -1))
+
+	// does not overflow for large n
+	expected, _ := new(big.Int).SetString("100891344545564193334812497256", 10)
+	assert.Equal(t, expected, ro.Binomial(100, 50))
+}
+
+func TestFallingFactorial(t *testing.T) {
+	assert.Equal(t, big.NewInt(60), ro.FallingFactorial(5, 3))
+	assert.Equal(t, big.NewInt(120), ro.FallingFactorial(5, 5))
+	assert.Equal(t, big.NewInt(1), ro.FallingFactorial(5, 0))
+	assert.Equal(t, big.NewInt(0), ro.FallingFactorial(5, 6))
+
+	expected, _ := new(big.Int).SetString("2432902008176640000", 10)
+	assert.Equal(t, expected, ro.FallingFactorial(20, 20))
+	assert.Equal(t, 1, ro.FallingFactorial(30, 30).Cmp(expected))
+}
+
+func TestCombinationAt(t *testing.T) {
+	arr := []string{"A", "B", "C", "D", "E", "F"}
+	for k := 1; k <= len(arr); k++ {
+		for i, comb := range ro.ToSlice(ro.Combinations(arr, k)) {
+			res, err := ro.CombinationAt(arr, k, big.NewInt(int64(i)))
+			assert.NoError(t, err)
+			assert.Equal(t, comb, res)
+		}
+		_, err := ro.CombinationAt(arr, k, ro.Binomial(len(arr), k))
+		assert.ErrorIs(t, err, ro.ErrIndexOutOfRange)
+	}
+
+	_, err := ro.CombinationAt(arr, 2, big.NewInt(-1))
+	assert.ErrorIs(t, err, ro.ErrIndexOutOfRange)
+
+	_, err = ro.CombinationAt(arr, 0, big.NewInt(0))
+	assert.ErrorIs(t, err, ro.ErrIndexOutOfRange)
+
+	_, err = ro.CombinationAt([]string{}, 2, big.NewInt(0))
+	assert.ErrorIs(t, err, ro.ErrIndexOutOfRange)
+
+	res, err := ro.CombinationAt(arr, 10, big.NewInt(0))
+	assert.NoError(t, err)
+	assert.Equal(t, arr, res)
+
+	large := ro.ToSlice(ro.Range(0, 100, 1))
+	last := new(big.Int).Sub(ro.Binomial(100, 50), big.NewInt(1))
+	res2, err := ro.CombinationAt(large, 50, last)
+	assert.NoError(t, err)
+	assert.Equal(t, ro.ToSlice(ro.Range(50, 100, 1)), res2)
+}
+
+func TestPermutationAt(t *testing.T) {
+	arr := []string{"A", "B", "C", "D", "E"}
+	for k := 1; k <= len(arr); k++ {
+		for i, perm := range ro.ToSlice(ro.Permutations(arr, k)) {
+			res, err := ro.PermutationAt(arr, k, big.NewInt(int64(i)))
+			assert.NoError(t, err)
+			assert.Equal(t, perm, res)
+		}
+		_, err := ro.PermutationAt(arr, k, ro.FallingFactorial(len(arr), k))
+		assert.ErrorIs(t, err, ro.ErrIndexOutOfRange)
+	}
+
+	_, err := ro.PermutationAt(arr, 0, big.NewInt(0))
+	assert.ErrorIs(t, err, ro.ErrIndexOutOfRange)
+
+	large := ro.ToSlice(ro.Range(0, 30, 1))
+	last := new(big.Int).Sub(ro.FallingFactorial(30, 30), big.NewInt(1))
+	res, err := ro.PermutationAt(large, 30, last)
+	assert.NoError(t, err)
+	slices.Reverse(large)
+	assert.Equal(t, large, res)
+}
+
+func TestCombinationRank(t *testing.T) {
+	n := 7
+	positions := ro.ToSlice(ro.Range(0, n, 1))
+	for k := 1; k <= n; k++ {
+		for i, comb := range ro.ToSlice(ro.Combinations(positions, k)) {
+			rank, err := ro.CombinationRank(n, comb)
+			assert.NoError(t, err)
+			assert.Equal(t, big.NewInt(int64(i)), rank)
+		}
+	}
+
+	_, err := ro.CombinationRank(5, []int{2, 1})
+	assert.ErrorIs(t, err, ro.ErrInvalidIndices)
+	_, err = ro.CombinationRank(5, []int{1, 5})
+	assert.ErrorIs(t, err, ro.ErrInvalidIndices)
+	_, err = ro.CombinationRank(2, []int{0, 1, 2})
+	assert.ErrorIs(t, err, ro.ErrInvalidIndices)
+
+	large := ro.ToSlice(ro.Range(50, 100, 1))
+	rank, err := ro.CombinationRank(100, large)
+	assert.NoError(t, err)
+	assert.Equal(t, new(big.Int).Sub(ro.Binomial(100, 50), big.NewInt(1)), rank)
+}
+
+func TestPermutationRank(t *testing.T) {
+	n := 5
+	positions := ro.ToSlice(ro.Range(0, n, 1))
+	for k := 1; k <= n; k++ {
+		for i, perm := range ro.ToSlice(ro.Permutations(positions, k)) {
+			rank, err := ro.PermutationRank(n, perm)
+			assert.NoError(t, err)
+			assert.Equal(t, big.NewInt(int64(i)), rank)
+		}
+	}
+
+	_, err := ro.PermutationRank(5, []int{1, 1})
+	assert.ErrorIs(t, err, ro.ErrInvalidIndices)
+	_, err = ro.PermutationRank(5, []int{-1})
+	assert.ErrorIs(t, err, ro.ErrInvalidIndices)
+
+	idx, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	large := ro.ToSlice(ro.Range(0, 40, 1))
+	perm, err := ro.PermutationAt(large, 40, idx)
+	assert.NoError(t, err)
+	rank, err := ro.PermutationRank(40, perm)
+	assert.NoError(t, err)
+	assert.Equal(t, idx, rank)
+}
@@ -2,10 +2,11 @@ package ro
 
 import (
 	"iter"
+	"sync"
 )
 
 // AccumulateSlice returns an iterator that yields the accumulated sum of the elements in the slice
-func AccumulateSlice[T intType](arr []T) iter.Seq[T] {
+func AccumulateSlice[T numberType](arr []T) iter.Seq[T] {
 	return func(yield func(T) bool) {
 		sum := T(0)
 		for _, v := range arr {
@@ -18,7 +19,7 @@ func AccumulateSlice[T intType](arr []T) iter.Seq[T] {
 }
 
 // Accumulate returns an iterator that yields the accumulated sum of the elements yielded by seq
-func Accumulate[T intType](seq iter.Seq[T]) iter.Seq[T] {
+func Accumulate[T numberType](seq iter.Seq[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
 		sum := T(0)
 		for v := range seq {
@@ -326,21 +327,144 @@ func RangeOver[T any](seq iter.Seq[T], start, step, stop int) iter.Seq[T] {
 
 }
 
+// teeBuffer pulls elements from a single source and buffers them until every branch has read them.
+//
+// Branches are identified by their index. A branch whose cursor is -1 is closed.
+//
+// mu guards the buffer and the cursors but is released while pulling from the source, so branches can read
+// buffered elements while another branch waits on the source. Pulls are serialized by the pulling flag.
+type teeBuffer[T any] struct {
+	mu        sync.Mutex
+	pulled    *sync.Cond
+	pulling   bool
+	seq       iter.Seq[T]
+	next      func() (T, bool)
+	stop      func()
+	exhausted bool
+
+	// buf[0] is the element at absolute position offset in the source
+	buf     []T
+	offset  int
+	cursors []int
+}
+
+func newTeeBuffer[T any](seq iter.Seq[T], n int) *teeBuffer[T] {
+	t := &teeBuffer[T]{
+		seq:     seq,
+		cursors: make([]int, n),
+	}
+	t.pulled = sync.NewCond(&t.mu)
+	return t
+}
+
+// get returns the next element for the branch, pulling it from the source if no other branch has read it yet
+func (t *teeBuffer[T]) get(branch int) (T, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var zero T
+	for {
+		pos := t.cursors[branch]
+		if pos < 0 {
+			return zero, false
+		}
+		if pos-t.offset < len(t.buf) {
+			v := t.buf[pos-t.offset]
+			t.cursors[branch]++
+			t.release()
+			return v, true
+		}
+		if t.exhausted {
+			return zero, false
+		}
+		if t.pulling {
+			// another branch is pulling the next element
+			t.pulled.Wait()
+			continue
+		}
+		if t.next == nil {
+			t.next, t.stop = iter.Pull(t.seq)
+		}
+		t.pulling = true
+		t.mu.Unlock()
+		v, ok := t.next()
+		t.mu.Lock()
+		t.pulling = false
+		t.pulled.Broadcast()
+		if !ok {
+			t.exhausted = true
+			t.stop()
+			return zero, false
+		}
+		t.buf = append(t.buf, v)
+	}
+}
+
+// close marks the branch as closed, stopping the source once every branch is closed
+func (t *teeBuffer[T]) close(branch int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.cursors[branch] < 0 {
+		return
+	}
+	t.cursors[branch] = -1
+	t.release()
+}
+
+// release frees the buffered elements that every open branch has read
+func (t *teeBuffer[T]) release() {
+	low := -1
+	for _, c := range t.cursors {
+		if c >= 0 && (low < 0 || c < low) {
+			low = c
+		}
+	}
+	if low < 0 {
+		// every branch is closed
+		if t.stop != nil {
+			t.stop()
+		}
+		t.buf = nil
+		return
+	}
+	drop := low - t.offset
+	if drop <= 0 {
+		return
+	}
+	clear(t.buf[:drop])
+	t.buf = t.buf[drop:]
+	t.offset = low
+}
+
+func (t *teeBuffer[T]) branch(i int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		defer t.close(i)
+		for {
+			v, ok := t.get(i)
+			if !ok || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
 // Tee returns n iterators that yield the elements of the sequence
 // If n == 1, the only element in the slice will be the original seq
+//
+// The sequence is only consumed once : elements are buffered until every branch has read them,
+// so branches can be consumed at their own pace and from different goroutines. Each branch can only be ranged over once,
+// and the buffer is freed once every branch has been exhausted or stopped. A branch that is never ranged over
+// keeps every element buffered.
 func Tee[T any](seq iter.Seq[T], n int) []iter.Seq[T] {
 	if n == 1 {
 		return []iter.Seq[T]{seq}
 	}
 	res := []iter.Seq[T]{}
+	if n <= 0 {
+		return res
+	}
+	t := newTeeBuffer(seq, n)
 	for i := 0; i < n; i++ {
-		res = append(res, func(yield func(T) bool) {
-			for v := range seq {
-				if !yield(v) {
-					break
-				}
-			}
-		})
+		res = append(res, t.branch(i))
 	}
 	return res
 }
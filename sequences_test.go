@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/alexandreLamarre/ro"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -53,6 +54,12 @@ func TestAccumulate(t *testing.T) {
 		break
 	}
 	assert.Equal(t, []int{1}, res3)
+
+	res4 := ro.ToSlice(ro.Accumulate(ro.FromSlice([]float64{0.5, 0.25, 1})))
+	assert.Equal(t, []float64{0.5, 0.75, 1.75}, res4)
+
+	res5 := ro.ToSlice(ro.AccumulateSlice([]complex64{complex(1, 1), complex(0, -2)}))
+	assert.Equal(t, []complex64{complex(1, 1), complex(1, -1)}, res5)
 }
 
 func TestAccumulateFuncSlice(t *testing.T) {
@@ -681,3 +688,118 @@ func TestTee(t *testing.T) {
 	}
 	assert.Equal(t, []int{1}, resOut)
 }
+
+func TestTeeSinglePass(t *testing.T) {
+	pulled := 0
+	src := func(yield func(int) bool) {
+		for i := 1; i <= 5; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	iters := ro.Tee(src, 2)
+	res := []lo.Tuple2[int, int]{}
+	for v := range ro.Zip(iters[0], iters[1]) {
+		res = append(res, v)
+	}
+	assert.Equal(t, []lo.Tuple2[int, int]{{A: 1, B: 1}, {A: 2, B: 2}, {A: 3, B: 3}, {A: 4, B: 4}, {A: 5, B: 5}}, res)
+	assert.Equal(t, 5, pulled)
+
+	// single-use sources are shared between the branches
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	iters2 := ro.Tee(ro.FromChan(ch), 2)
+	assert.Equal(t, []int{1, 2, 3}, ro.ToSlice(iters2[0]))
+	assert.Equal(t, []int{1, 2, 3}, ro.ToSlice(iters2[1]))
+
+	// branches can only be ranged over once
+	assert.Equal(t, []int{}, ro.ToSlice(iters2[0]))
+
+	// stopping one branch early does not affect the others
+	iters3 := ro.Tee(ro.Count(0, 1), 2)
+	res3 := []int{}
+	for v := range iters3[0] {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, []int{0}, res3)
+	assert.Equal(t, []int{0, 1, 2}, ro.ToSlice(ro.Limit(iters3[1], 3)))
+}
+
+func TestTeeGoroutines(t *testing.T) {
+	pulled := 0
+	src := func(yield func(int) bool) {
+		for i := 0; i < 1000; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	iters := ro.Tee(src, 4)
+	res := make([][]int, 4)
+	var wg sync.WaitGroup
+	for i, it := range iters {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res[i] = ro.ToSlice(it)
+		}()
+	}
+	wg.Wait()
+	for _, r := range res {
+		assert.Equal(t, ro.ToSlice(ro.Range(0, 1000, 1)), r)
+	}
+	assert.Equal(t, 1000, pulled)
+
+	assert.Len(t, ro.Tee(src, 0), 0)
+}
+
+func TestTeeBlockedSource(t *testing.T) {
+	waiting := make(chan struct{})
+	resume := make(chan struct{})
+	src := func(yield func(int) bool) {
+		if !yield(1) {
+			return
+		}
+		// the source blocks while pulling the second element
+		close(waiting)
+		<-resume
+		yield(2)
+	}
+	iters := ro.Tee(src, 2)
+
+	res0 := []int{}
+	done0 := make(chan struct{})
+	go func() {
+		defer close(done0)
+		res0 = ro.ToSlice(iters[0])
+	}()
+	<-waiting
+
+	// the second branch reads the buffered element while the first one waits on the source
+	next, stop := iter.Pull(iters[1])
+	defer stop()
+	read := make(chan struct{})
+	var v int
+	go func() {
+		defer close(read)
+		v, _ = next()
+	}()
+	assertClosed(t, read, "branch blocked on the source pull of another branch")
+	assert.Equal(t, 1, v)
+
+	close(resume)
+	assertClosed(t, done0, "branch did not finish")
+	assert.Equal(t, []int{1, 2}, res0)
+	v, ok := next()
+	assert.True(t, ok)
+	assert.Equal(t, 2, v)
+	_, ok = next()
+	assert.False(t, ok)
+}
@@ -0,0 +1,122 @@
+package ro
+
+import (
+	"iter"
+	"math"
+	"math/big"
+	"slices"
+)
+
+// shardBounds splits [0, total) into n contiguous ranges of nearly equal size
+func shardBounds(total *big.Int, n int) []*big.Int {
+	bounds := make([]*big.Int, n+1)
+	shards := big.NewInt(int64(n))
+	for i := range bounds {
+		b := new(big.Int).Mul(total, big.NewInt(int64(i)))
+		bounds[i] = b.Quo(b, shards)
+	}
+	return bounds
+}
+
+// shardLimit returns the number of elements in [start, end), saturating at math.MaxUint64
+func shardLimit(start, end *big.Int) uint64 {
+	count := new(big.Int).Sub(end, start)
+	if !count.IsUint64() {
+		return math.MaxUint64
+	}
+	return count.Uint64()
+}
+
+// ShardCombinations returns shards iterators that together yield all possible k-length combinations of the slice.
+// Each iterator yields a contiguous range of the order yielded by Combinations, and the ranges are disjoint,
+// so the iterators can be consumed independently, e.g. from different goroutines.
+// If shards <= 0, an empty slice is returned.
+// If k <= 0, every iterator is empty.
+// If k > len(seq), k is reduced to len(seq)
+func ShardCombinations[T any](arr []T, k int, shards int) []iter.Seq[[]T] {
+	res := []iter.Seq[[]T]{}
+	if shards <= 0 {
+		return res
+	}
+	k = min(k, len(arr))
+	if k <= 0 {
+		for i := 0; i < shards; i++ {
+			res = append(res, empty[[]T]())
+		}
+		return res
+	}
+	bounds := shardBounds(Binomial(len(arr), k), shards)
+	for i := 0; i < shards; i++ {
+		start, end := bounds[i], bounds[i+1]
+		res = append(res, func(yield func([]T) bool) {
+			limit := shardLimit(start, end)
+			if limit == 0 {
+				return
+			}
+			indices, err := combinationIndicesAt(len(arr), k, start)
+			if err != nil {
+				return
+			}
+			for n := uint64(0); n < limit; n++ {
+				if !yield(pick(arr, indices)) {
+					return
+				}
+				if !nextCombination(indices, len(arr)) {
+					return
+				}
+			}
+		})
+	}
+	return res
+}
+
+// ShardPermutations returns shards iterators that together yield all possible k-length permutations of the slice.
+// Each iterator yields a contiguous range of the order yielded by Permutations, and the ranges are disjoint,
+// so the iterators can be consumed independently, e.g. from different goroutines.
+// If shards <= 0, an empty slice is returned.
+// If k <= 0, every iterator is empty.
+// If k > len(seq), k is reduced to len(seq)
+func ShardPermutations[T any](arr []T, k int, shards int) []iter.Seq[[]T] {
+	res := []iter.Seq[[]T]{}
+	if shards <= 0 {
+		return res
+	}
+	if k <= 0 {
+		for i := 0; i < shards; i++ {
+			res = append(res, empty[[]T]())
+		}
+		return res
+	}
+	k = min(k, len(arr))
+	bounds := shardBounds(FallingFactorial(len(arr), k), shards)
+	for i := 0; i < shards; i++ {
+		start, end := bounds[i], bounds[i+1]
+		res = append(res, func(yield func([]T) bool) {
+			limit := shardLimit(start, end)
+			if limit == 0 {
+				return
+			}
+			prefix, err := permutationIndicesAt(len(arr), k, start)
+			if err != nil {
+				return
+			}
+			// nextKPermutation expects the unused positions to follow the prefix in increasing order
+			indices := make([]int, 0, len(arr))
+			indices = append(indices, prefix...)
+			for j := 0; j < len(arr); j++ {
+				if !slices.Contains(prefix, j) {
+					indices = append(indices, j)
+				}
+			}
+			for n := uint64(0); n < limit; n++ {
+				if !yield(pick(arr, indices[:k])) {
+					return
+				}
+				if !nextKPermutation(indices, k) {
+					return
+				}
+			}
+		})
+	}
+	return res
+}
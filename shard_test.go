@@ -0,0 +1,127 @@
+package ro_test
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShardCombinations(t *testing.T) {
+	arr := []string{"A", "B", "C", "D", "E", "F", "G"}
+	for _, shards := range []int{1, 2, 3, 7, 50} {
+		for k := 1; k <= len(arr); k++ {
+			seqs := ro.ShardCombinations(arr, k, shards)
+			assert.Len(t, seqs, shards)
+			res := [][]string{}
+			for _, seq := range seqs {
+				res = append(res, ro.ToSlice(seq)...)
+			}
+			assert.Equal(t, ro.ToSlice(ro.Combinations(arr, k)), res, "shards=%d k=%d", shards, k)
+		}
+	}
+
+	// shards are contiguous ranges of nearly equal size
+	seqs := ro.ShardCombinations(ro.ToSlice(ro.Range(0, 10, 1)), 3, 4)
+	sizes := []int{}
+	for _, seq := range seqs {
+		sizes = append(sizes, len(ro.ToSlice(seq)))
+	}
+	assert.Equal(t, []int{30, 30, 30, 30}, sizes)
+	assert.Equal(t, [][]int{{0, 6, 7}}, ro.ToSlice(ro.Limit(seqs[1], 1)))
+
+	assert.Len(t, ro.ShardCombinations(arr, 2, 0), 0)
+	for _, seq := range ro.ShardCombinations(arr, 0, 3) {
+		assert.Equal(t, [][]string{}, ro.ToSlice(seq))
+	}
+	for _, seq := range ro.ShardCombinations([]string{}, 2, 3) {
+		assert.Equal(t, [][]string{}, ro.ToSlice(seq))
+	}
+
+	res := [][]string{}
+	for v := range ro.ShardCombinations(arr, 2, 2)[1] {
+		res = append(res, v)
+		break
+	}
+	assert.Len(t, res, 1)
+}
+
+func TestShardPermutations(t *testing.T) {
+	arr := []string{"A", "B", "C", "D", "E"}
+	for _, shards := range []int{1, 2, 3, 7, 200} {
+		for k := 1; k <= len(arr); k++ {
+			seqs := ro.ShardPermutations(arr, k, shards)
+			assert.Len(t, seqs, shards)
+			res := [][]string{}
+			for _, seq := range seqs {
+				res = append(res, ro.ToSlice(seq)...)
+			}
+			assert.Equal(t, ro.ToSlice(ro.Permutations(arr, k)), res, "shards=%d k=%d", shards, k)
+		}
+	}
+
+	assert.Len(t, ro.ShardPermutations(arr, 2, -1), 0)
+	for _, seq := range ro.ShardPermutations(arr, 0, 3) {
+		assert.Equal(t, [][]string{}, ro.ToSlice(seq))
+	}
+
+	// shards of very large spaces can be consumed independently
+	large := ro.ToSlice(ro.Range(0, 30, 1))
+	seqs := ro.ShardPermutations(large, 30, 4)
+	first := ro.ToSlice(ro.Limit(seqs[3], 1))
+	assert.Len(t, first, 1)
+	assert.Equal(t, 22, first[0][0])
+}
+
+func TestParallelForEach(t *testing.T) {
+	var mu sync.Mutex
+	res := [][]int{}
+	ok := ro.ParallelForEach(ro.ShardCombinations(ro.ToSlice(ro.Range(0, 8, 1)), 3, 4), 2, func(comb []int) bool {
+		mu.Lock()
+		defer mu.Unlock()
+		res = append(res, comb)
+		return true
+	})
+	assert.True(t, ok)
+	assert.ElementsMatch(t, ro.ToSlice(ro.Combinations(ro.ToSlice(ro.Range(0, 8, 1)), 3)), res)
+
+	// stops every shard once a result is found
+	var calls atomic.Int64
+	var found atomic.Value
+	// the fifth shard of the 12! permutations starts with the first permutation beginning with 6
+	ok = ro.ParallelForEach(ro.ShardPermutations(ro.ToSlice(ro.Range(0, 12, 1)), 12, 8), 8, func(perm []int) bool {
+		calls.Add(1)
+		if perm[0] == 6 {
+			found.Store(perm)
+			return false
+		}
+		return true
+	})
+	assert.False(t, ok)
+	assert.NotNil(t, found.Load())
+	assert.Less(t, calls.Load(), int64(10000000))
+
+	// remaining shards are not started once a worker has stopped
+	stoppedCh := make(chan struct{})
+	stopper := func(yield func(int) bool) {
+		yield(-1)
+		close(stoppedCh)
+	}
+	// the other worker finishes its shard after the stop, then looks for the next one
+	waiter := func(func(int) bool) {
+		<-stoppedCh
+	}
+	var started atomic.Int64
+	shard := func(yield func(int) bool) {
+		started.Add(1)
+		yield(0)
+	}
+	ok = ro.ParallelForEach([]iter.Seq[int]{stopper, waiter, shard, shard, shard}, 2, func(v int) bool { return v != -1 })
+	assert.False(t, ok)
+	assert.Equal(t, int64(0), started.Load())
+
+	assert.True(t, ro.ParallelForEach([]iter.Seq[int]{}, 4, func(int) bool { return false }))
+}
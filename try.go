@@ -0,0 +1,177 @@
+package ro
+
+import (
+	"errors"
+	"iter"
+)
+
+// The Try family operates on fallible sequences of the form iter.Seq2[T, error].
+//
+// Errors yielded by the source sequence are passed through unchanged with a zero value,
+// and iteration continues after them. Consumers choose to short-circuit on the first error,
+// via TryStopOnError or TryToSlice, or to collect every error, via TryToSliceAll.
+
+// Try is a convenience wrapper to convert an infallible iterator to a fallible one
+func Try[T any](seq iter.Seq[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v := range seq {
+			if !yield(v, nil) {
+				break
+			}
+		}
+	}
+}
+
+// TryApply returns an iterator that yields the result of applying f to each element yielded by the sequence.
+// Errors returned by f are yielded in place of the result.
+func TryApply[U, V any](seq iter.Seq2[U, error], f func(U) (V, error)) iter.Seq2[V, error] {
+	return func(yield func(V, error) bool) {
+		var zero V
+		for u, err := range seq {
+			if err != nil {
+				if !yield(zero, err) {
+					break
+				}
+				continue
+			}
+			v, err := f(u)
+			if err != nil {
+				v = zero
+			}
+			if !yield(v, err) {
+				break
+			}
+		}
+	}
+}
+
+// TryFilter returns an iterator that yields elements matching the predicate.
+// Errors returned by the predicate are yielded in place of the element.
+func TryFilter[T any](seq iter.Seq2[T, error], predicate func(T) (bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for v, err := range seq {
+			if err != nil {
+				if !yield(zero, err) {
+					break
+				}
+				continue
+			}
+			ok, err := predicate(v)
+			if err != nil {
+				if !yield(zero, err) {
+					break
+				}
+				continue
+			}
+			if ok {
+				if !yield(v, nil) {
+					break
+				}
+			}
+		}
+	}
+}
+
+// TryAccumulateFunc returns an iterator that yields the accumulated result of applying f to the elements yielded by seq.
+// Errors returned by f are yielded in place of the accumulated result, which is left unchanged.
+func TryAccumulateFunc[T any](seq iter.Seq2[T, error], f func(T, T) (T, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var agg, zero T
+		for v, err := range seq {
+			if err != nil {
+				if !yield(zero, err) {
+					break
+				}
+				continue
+			}
+			next, err := f(agg, v)
+			if err != nil {
+				if !yield(zero, err) {
+					break
+				}
+				continue
+			}
+			agg = next
+			if !yield(agg, nil) {
+				break
+			}
+		}
+	}
+}
+
+// TryLimit returns an iterator that yields up to the first n successful elements yielded by the sequence.
+// Errors encountered before the n-th element are passed through and do not count towards n.
+// The sequence is not pulled past the n-th successful element.
+func TryLimit[T any](seq iter.Seq2[T, error], n int) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+		k := 0
+		for v, err := range seq {
+			if err == nil {
+				k++
+			}
+			if !yield(v, err) || k >= n {
+				break
+			}
+		}
+	}
+}
+
+// TryChain returns an iterator that yields the elements yielded by seqs in order
+func TryChain[T any](seqs ...iter.Seq2[T, error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for _, it := range seqs {
+			for v, err := range it {
+				if !yield(v, err) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TryStopOnError returns an iterator that yields the elements of the sequence up to and including the first error
+func TryStopOnError[T any](seq iter.Seq2[T, error]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for v, err := range seq {
+			if !yield(v, err) || err != nil {
+				break
+			}
+		}
+	}
+}
+
+// TryToSlice is a convenience wrapper to convert a fallible iterator to a slice.
+// It stops at the first error, returning the elements collected so far along with that error.
+//
+// This will block until the iterator is exhausted or an error is encountered.
+func TryToSlice[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	res := []T{}
+	for v, err := range seq {
+		if err != nil {
+			return res, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// TryToSliceAll is a convenience wrapper to convert a fallible iterator to a slice.
+// It consumes the whole iterator, collecting every successful element and joining every error with errors.Join.
+//
+// This will block until the iterator is exhausted.
+func TryToSliceAll[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	res := []T{}
+	errs := []error{}
+	for v, err := range seq {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		res = append(res, v)
+	}
+	return res, errors.Join(errs...)
+}
@@ -0,0 +1,210 @@
+package ro_test
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/alexandreLamarre/ro"
+	"github.com/stretchr/testify/assert"
+)
+
+var errOdd = errors.New("odd")
+
+// failOdd yields an error in place of every odd element of arr
+func failOdd(arr []int) iter.Seq2[int, error] {
+	return func(yield func(int, error) bool) {
+		for _, v := range arr {
+			var err error
+			if v%2 != 0 {
+				err = fmt.Errorf("%d: %w", v, errOdd)
+				v = 0
+			}
+			if !yield(v, err) {
+				break
+			}
+		}
+	}
+}
+
+func TestTry(t *testing.T) {
+	res, err := ro.TryToSlice(ro.Try(ro.FromSlice([]int{1, 2, 3})))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, res)
+
+	res2, err := ro.TryToSlice(ro.Try(ro.FromSlice([]int{})))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, res2)
+
+	res3 := []int{}
+	for v := range ro.Try(ro.FromSlice([]int{1, 2, 3})) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res3)
+}
+
+func TestTryApply(t *testing.T) {
+	res, err := ro.TryToSliceAll(ro.TryApply(failOdd([]int{1, 2, 3, 4}), func(i int) (string, error) {
+		return fmt.Sprint(i * 2), nil
+	}))
+	assert.Equal(t, []string{"4", "8"}, res)
+	assert.ErrorIs(t, err, errOdd)
+	assert.EqualError(t, err, "1: odd\n3: odd")
+
+	errTooBig := errors.New("too big")
+	res2, err := ro.TryToSlice(ro.TryApply(ro.Try(ro.FromSlice([]int{1, 2, 3})), func(i int) (int, error) {
+		if i > 1 {
+			return 0, errTooBig
+		}
+		return i, nil
+	}))
+	assert.Equal(t, []int{1}, res2)
+	assert.ErrorIs(t, err, errTooBig)
+
+	res3 := []int{}
+	for v := range ro.TryApply(failOdd([]int{2, 4}), func(i int) (int, error) { return i, nil }) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, []int{2}, res3)
+
+	// a value returned along with an error is replaced by the zero value
+	vals, errs := []int{}, []error{}
+	for v, err := range ro.TryApply(ro.Try(ro.FromSlice([]int{1, 2})), func(i int) (int, error) {
+		if i == 2 {
+			return 42, errTooBig
+		}
+		return i, nil
+	}) {
+		vals = append(vals, v)
+		errs = append(errs, err)
+	}
+	assert.Equal(t, []int{1, 0}, vals)
+	assert.Equal(t, []error{nil, errTooBig}, errs)
+}
+
+func TestTryFilter(t *testing.T) {
+	res, err := ro.TryToSliceAll(ro.TryFilter(failOdd([]int{1, 2, 3, 4, 6}), func(i int) (bool, error) {
+		return i%4 != 0, nil
+	}))
+	assert.Equal(t, []int{2, 6}, res)
+	assert.ErrorIs(t, err, errOdd)
+
+	errNegative := errors.New("negative")
+	res2, err := ro.TryToSliceAll(ro.TryFilter(ro.Try(ro.FromSlice([]int{-1, 2, -3})), func(i int) (bool, error) {
+		if i < 0 {
+			return false, errNegative
+		}
+		return true, nil
+	}))
+	assert.Equal(t, []int{2}, res2)
+	assert.ErrorIs(t, err, errNegative)
+
+	res3, err := ro.TryToSlice(ro.TryFilter(ro.Try(ro.FromSlice([]int{})), func(int) (bool, error) { return true, nil }))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, res3)
+}
+
+func TestTryAccumulateFunc(t *testing.T) {
+	add := func(a, b int) (int, error) { return a + b, nil }
+	res, err := ro.TryToSliceAll(ro.TryAccumulateFunc(failOdd([]int{2, 3, 4}), add))
+	assert.Equal(t, []int{2, 6}, res)
+	assert.ErrorIs(t, err, errOdd)
+
+	errOverflow := errors.New("overflow")
+	res2, err := ro.TryToSliceAll(ro.TryAccumulateFunc(ro.Try(ro.FromSlice([]int{5, 10, 1})), func(a, b int) (int, error) {
+		if a+b > 10 {
+			return 0, errOverflow
+		}
+		return a + b, nil
+	}))
+	assert.Equal(t, []int{5, 6}, res2)
+	assert.ErrorIs(t, err, errOverflow)
+
+	res3 := []int{}
+	for v := range ro.TryAccumulateFunc(ro.Try(ro.FromSlice([]int{1, 2, 3})), add) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res3)
+}
+
+func TestTryLimit(t *testing.T) {
+	res, err := ro.TryToSliceAll(ro.TryLimit(failOdd([]int{1, 2, 3, 4, 6, 8}), 2))
+	assert.Equal(t, []int{2, 4}, res)
+	assert.EqualError(t, err, "1: odd\n3: odd")
+
+	res2, err := ro.TryToSlice(ro.TryLimit(ro.Try(ro.FromSlice([]int{1, 2})), 5))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, res2)
+
+	res3, err := ro.TryToSlice(ro.TryLimit(ro.Try(ro.Count(0, 1)), 3))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2}, res3)
+
+	// no element is fetched past the limit
+	fetched := 0
+	src := ro.Try(ro.Apply(ro.Count(0, 1), func(i int) int {
+		fetched++
+		return i
+	}))
+	res4, err := ro.TryToSlice(ro.TryLimit(src, 2))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1}, res4)
+	assert.Equal(t, 2, fetched)
+
+	fetched = 0
+	res5, err := ro.TryToSlice(ro.TryLimit(src, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, res5)
+	assert.Equal(t, 0, fetched)
+}
+
+func TestTryChain(t *testing.T) {
+	res, err := ro.TryToSliceAll(ro.TryChain(failOdd([]int{1, 2}), ro.Try(ro.FromSlice([]int{3})), failOdd([]int{4, 5})))
+	assert.Equal(t, []int{2, 3, 4}, res)
+	assert.EqualError(t, err, "1: odd\n5: odd")
+
+	res2, err := ro.TryToSlice(ro.TryChain[int]())
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, res2)
+
+	res3 := []int{}
+	for v := range ro.TryChain(failOdd([]int{2, 4}), failOdd([]int{6})) {
+		res3 = append(res3, v)
+		break
+	}
+	assert.Equal(t, []int{2}, res3)
+}
+
+func TestTryStopOnError(t *testing.T) {
+	res, err := ro.TryToSliceAll(ro.TryStopOnError(failOdd([]int{2, 4, 5, 6, 7})))
+	assert.Equal(t, []int{2, 4}, res)
+	assert.EqualError(t, err, "5: odd")
+
+	res2, err := ro.TryToSliceAll(ro.TryStopOnError(failOdd([]int{2, 4})))
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4}, res2)
+}
+
+func TestTryToSlice(t *testing.T) {
+	pulled := 0
+	src := func(yield func(int, error) bool) {
+		for v, err := range failOdd([]int{2, 3, 4, 5}) {
+			pulled++
+			if !yield(v, err) {
+				return
+			}
+		}
+	}
+	res, err := ro.TryToSlice(src)
+	assert.Equal(t, []int{2}, res)
+	assert.EqualError(t, err, "3: odd")
+	assert.Equal(t, 2, pulled)
+
+	res2, err := ro.TryToSliceAll(src)
+	assert.Equal(t, []int{2, 4}, res2)
+	assert.EqualError(t, err, "3: odd\n5: odd")
+}
@@ -1,6 +1,9 @@
 package ro
 
-import "iter"
+import (
+	"context"
+	"iter"
+)
 
 func empty[T any]() iter.Seq[T] {
 	return func(_ func(T) bool) {}
@@ -11,6 +14,42 @@ type intType interface {
 		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
 }
 
+// addChecked returns a + b, and false if the sum wrapped around the bounds of T
+func addChecked[T intType](a, b T) (T, bool) {
+	sum := a + b
+	if (b > 0 && sum < a) || (b < 0 && sum > a) {
+		return sum, false
+	}
+	return sum, true
+}
+
+// intBounds returns the smallest and largest values of T
+func intBounds[T intType]() (T, T) {
+	var zero T
+	if ^zero > 0 {
+		return zero, ^zero
+	}
+	// set one more low bit until the sign bit would be set
+	hi := T(1)
+	for next := hi<<1 | 1; next > hi; next = next<<1 | 1 {
+		hi = next
+	}
+	return ^hi, hi
+}
+
+// addSaturating returns a + b, clamped to the bounds of T
+func addSaturating[T intType](a, b T) T {
+	sum, ok := addChecked(a, b)
+	if ok {
+		return sum
+	}
+	lower, upper := intBounds[T]()
+	if b > 0 {
+		return upper
+	}
+	return lower
+}
+
 type floatType interface {
 	~float32 | ~float64
 }
@@ -19,10 +58,13 @@ type complexType interface {
 	~complex64 | ~complex128
 }
 
+// inexactType is satisfied by the types that support true division
+type inexactType interface {
+	floatType | complexType
+}
+
 type numberType interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 |
-		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
-	~float32 | ~float64 | ~complex64 | ~complex128
+	intType | floatType | complexType
 }
 
 // ToSlice is a convenience wrapper to convert an iterator to a slice
@@ -61,6 +103,42 @@ func FromString(s string) iter.Seq[rune] {
 	}
 }
 
+// FromChan is a convenience wrapper to convert a channel to an iterator
+//
+// The iterator yields received values until the channel is closed.
+func FromChan[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				break
+			}
+		}
+	}
+}
+
+// ToChan is a convenience wrapper to convert an iterator to a channel with a buffer of size buf
+//
+// The iterator is consumed by a new goroutine, and the channel is closed once the iterator is exhausted.
+// The goroutine exits early when ctx is done, so receivers that stop reading before the channel
+// is closed must cancel ctx to release it.
+func ToChan[T any](ctx context.Context, seq iter.Seq[T], buf int) <-chan T {
+	if buf < 0 {
+		buf = 0
+	}
+	ch := make(chan T, buf)
+	go func() {
+		defer close(ch)
+		for v := range seq {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- v:
+			}
+		}
+	}()
+	return ch
+}
+
 // Extend pads an iterator with an empty struct to conform to an iter.Seq2 type
 func Extend[T any](seq iter.Seq[T]) iter.Seq2[struct{}, T] {
 	return func(yield func(struct{}, T) bool) {
@@ -1,8 +1,10 @@
 package ro_test
 
 import (
+	"context"
 	"iter"
 	"testing"
+	"time"
 
 	"github.com/alexandreLamarre/ro"
 	"github.com/samber/lo"
@@ -101,3 +103,83 @@ func TestExtend(t *testing.T) {
 		{A: struct{}{}, B: 1},
 	}, res3)
 }
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	assert.Equal(t, []int{1, 2, 3}, ro.ToSlice(ro.FromChan(ch)))
+
+	ch2 := make(chan int)
+	close(ch2)
+	assert.Equal(t, []int{}, ro.ToSlice(ro.FromChan(ch2)))
+
+	ch3 := make(chan int)
+	go func() {
+		defer close(ch3)
+		for i := 0; i < 3; i++ {
+			ch3 <- i
+		}
+	}()
+	res3 := []lo.Tuple2[int, string]{}
+	for v := range ro.Zip(ro.FromChan(ch3), ro.FromSlice([]string{"a", "b", "c"})) {
+		res3 = append(res3, v)
+	}
+	assert.Equal(t, []lo.Tuple2[int, string]{{A: 0, B: "a"}, {A: 1, B: "b"}, {A: 2, B: "c"}}, res3)
+
+	ch4 := make(chan int, 3)
+	ch4 <- 1
+	ch4 <- 2
+	ch4 <- 3
+	res4 := []int{}
+	for v := range ro.FromChan(ch4) {
+		res4 = append(res4, v)
+		break
+	}
+	assert.Equal(t, []int{1}, res4)
+	assert.Len(t, ch4, 2)
+}
+
+func TestToChan(t *testing.T) {
+	ctx := context.Background()
+	res := []int{}
+	for v := range ro.ToChan(ctx, ro.Range(0, 5, 1), 0) {
+		res = append(res, v)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, res)
+
+	res2 := []int{}
+	for v := range ro.ToChan(ctx, ro.FromSlice([]int{}), 2) {
+		res2 = append(res2, v)
+	}
+	assert.Equal(t, []int{}, res2)
+
+	ch3 := ro.ToChan(ctx, ro.Range(0, 5, 1), 5)
+	assert.Eventually(t, func() bool { return len(ch3) == 5 }, time.Second, time.Millisecond)
+
+	cctx, cancel := context.WithCancel(ctx)
+	ch4 := ro.ToChan(cctx, ro.Count(0, 1), 0)
+	assert.Equal(t, 0, <-ch4)
+	cancel()
+	// the producer exits on cancellation and closes the channel
+	assert.Eventually(t, func() bool {
+		for {
+			select {
+			case _, ok := <-ch4:
+				if !ok {
+					return true
+				}
+			default:
+				return false
+			}
+		}
+	}, time.Second, time.Millisecond)
+
+	res5 := ro.ToSlice(ro.Chain(
+		ro.FromChan(ro.ToChan(ctx, ro.Range(0, 3, 1), 1)),
+		ro.FromChan(ro.ToChan(ctx, ro.Range(3, 6, 1), 1)),
+	))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, res5)
+}